@@ -23,9 +23,11 @@ import (
 	"github.com/pkg/errors"
 )
 
-func detectCI() (string, bool) {
+// DetectCI returns the name of the CI system the command runs on, if any.
+func DetectCI() (string, bool) {
 	for k, v := range map[string]string{
 		"GITHUB_WORKFLOW": "github-actions",
+		"GITLAB_CI":       "gitlab",
 		"CIRCLECI":        "circle-ci",
 		"JENKINS_HOME":    "jenkins",
 		"BUILDKITE":       "buildkite",
@@ -161,7 +163,7 @@ func saveData(server string, data *EarthlyAnalytics) error {
 // CollectAnalytics sends analytics to api.earthly.dev
 func CollectAnalytics(ctx context.Context, earthlyServer string, displayErrors bool, version, gitSha, commandName string, exitCode int, realtime time.Duration) {
 	var err error
-	ciName, ci := detectCI()
+	ciName, ci := DetectCI()
 	repoHash := getRepoHash()
 	installID, overrideInstallID := os.LookupEnv("EARTHLY_INSTALL_ID")
 	if !overrideInstallID {
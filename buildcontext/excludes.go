@@ -19,7 +19,9 @@ var ImplicitExcludes = []string{
 	EarthIgnoreFile,
 }
 
-func readExcludes(dir string) ([]string, error) {
+// ReadExcludes returns the patterns of the files excluded from the build context of the given
+// directory, as specified by its .earthignore file, together with the implicit ones.
+func ReadExcludes(dir string) ([]string, error) {
 	filePath := filepath.Join(dir, EarthIgnoreFile)
 	f, err := os.Open(filePath)
 	if err != nil {
@@ -22,7 +22,7 @@ func (lr *localResolver) resolveLocal(ctx context.Context, target domain.Target)
 	if target.IsRemote() {
 		return nil, fmt.Errorf("unexpected remote target %s", target.String())
 	}
-	excludes, err := readExcludes(target.LocalPath)
+	excludes, err := ReadExcludes(target.LocalPath)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,84 @@
+package builder
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/earthly/earthly/conslogging"
+	"github.com/earthly/earthly/domain"
+	"github.com/earthly/earthly/earthfile2llb"
+	"github.com/earthly/earthly/fileutil"
+)
+
+// annotateFailures prints a CI annotation for each failed command, pointing at its line in the
+// Earthfile. Failures of remote targets are not annotated.
+func (sm *solverMonitor) annotateFailures(failedVertices []*vertexMonitor) {
+	if sm.console.GroupStyle() != conslogging.GitHubGroups {
+		return
+	}
+	for _, vm := range failedVertices {
+		filename, line, ok := failureLocation(vm)
+		if !ok {
+			continue
+		}
+		msg := strings.Join([]string{vm.targetStr, vm.operation, failureReason(vm.vertex.Error)}, ": ")
+		sm.console.PrintErrorAnnotation(filepath.ToSlash(filename), line, msg)
+	}
+}
+
+// failureLocation returns the Earthfile and the line of the command of a vertex. The line is
+// that of the target header, if the command cannot be found.
+func failureLocation(vm *vertexMonitor) (string, int, bool) {
+	target, err := domain.ParseTarget(vm.targetStr)
+	if err != nil || target.IsRemote() || target.IsImportReference() {
+		return "", 0, false
+	}
+	filename := filepath.Join(target.LocalPath, "Earthfile")
+	if !fileutil.FileExists(filename) {
+		filename = filepath.Join(target.LocalPath, "build.earth")
+	}
+	ol, err := earthfile2llb.ReadOutline(filename)
+	if err != nil {
+		return "", 0, false
+	}
+	ot := ol.Target(target.Target)
+	if ot == nil {
+		return "", 0, false
+	}
+	dt, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return filename, ot.Line, true
+	}
+	return filename, commandLine(strings.Split(string(dt), "\n"), ot.Line, ot.EndLine, vm.operation), true
+}
+
+// commandLine returns the line within [startLine, endLine] (1-based) of the command which best
+// matches the operation of a vertex, or startLine if no line matches. A command continued over
+// several lines (with \) is matched as a whole.
+func commandLine(lines []string, startLine, endLine int, operation string) int {
+	opWords := strings.Fields(strings.NewReplacer("\"", "", "'", "").Replace(operation))
+	if len(opWords) == 0 {
+		return startLine
+	}
+	best, bestScore := startLine, 0
+	for line := startLine + 1; line <= endLine && line <= len(lines); line++ {
+		text := strings.TrimSpace(lines[line-1])
+		for next := line; strings.HasSuffix(text, "\\") && next < endLine && next < len(lines); next++ {
+			text = strings.TrimSuffix(text, "\\") + " " + strings.TrimSpace(lines[next])
+		}
+		text = strings.TrimSuffix(text, "\\")
+		words := strings.Fields(strings.NewReplacer("\"", "", "'", "").Replace(text))
+		if len(words) == 0 || words[0] != opWords[0] {
+			continue
+		}
+		score := 1
+		for score < len(words) && score < len(opWords) && words[score] == opWords[score] {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = line, score
+		}
+	}
+	return best
+}
@@ -0,0 +1,89 @@
+package builder
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+const testAnnotationEarthfile = `FROM alpine:3.11
+
+build:
+    COPY src src
+    RUN go build \
+        -o out ./...
+    RUN go build -o other ./cmd/other
+    SAVE ARTIFACT out
+
+test:
+    FROM +build
+    RUN echo "testing"
+    RUN go test ./...
+`
+
+func TestCommandLine(t *testing.T) {
+	lines := strings.Split(testAnnotationEarthfile, "\n")
+	var tests = []struct {
+		operation string
+		startLine int
+		endLine   int
+		expected  int
+	}{
+		{"COPY src src", 3, 8, 4},
+		{"RUN go build -o out ./...", 3, 8, 5},
+		{"RUN go build -o other ./cmd/other", 3, 8, 7},
+		{"SAVE ARTIFACT out", 3, 8, 8},
+		{"RUN echo testing", 10, 13, 12},
+		{"RUN go test ./...", 10, 13, 13},
+		{"RUN go test ./...", 3, 8, 5},
+		{"RUN --privileged ./run.sh", 10, 13, 12},
+		{"COPY +build/out ./", 10, 13, 10},
+		{"", 10, 13, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			Equal(t, tt.expected, commandLine(lines, tt.startLine, tt.endLine, tt.operation))
+		})
+	}
+}
+
+func TestFailureLocation(t *testing.T) {
+	dir, err := ioutil.TempDir("", "earthly-annotation")
+	if !NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	earthfile := filepath.Join(dir, "Earthfile")
+	err = ioutil.WriteFile(earthfile, []byte(testAnnotationEarthfile), 0644)
+	if !NoError(t, err) {
+		return
+	}
+	var tests = []struct {
+		targetStr string
+		operation string
+		filename  string
+		line      int
+		ok        bool
+	}{
+		{dir + "+test", "RUN go test ./...", earthfile, 13, true},
+		{dir + "+build", "RUN go build -o out ./...", earthfile, 5, true},
+		{dir + "+build", "GIT CLONE https://github.com/foo/bar.git bar", earthfile, 3, true},
+		{dir + "+missing", "RUN go test ./...", "", 0, false},
+		{"github.com/foo/bar+test", "RUN go test ./...", "", 0, false},
+		{"+lib+test", "RUN go test ./...", "", 0, false},
+		{"internal", "load metadata", "", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.targetStr, func(t *testing.T) {
+			vm := &vertexMonitor{targetStr: tt.targetStr, operation: tt.operation}
+			filename, line, ok := failureLocation(vm)
+			Equal(t, tt.ok, ok)
+			Equal(t, tt.filename, filename)
+			Equal(t, tt.line, line)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -53,6 +54,20 @@ type Opt struct {
 	BuildContextProvider *provider.BuildContextProvider
 	GitLookup            *buildcontext.GitLookup
 	UseFakeDep           bool
+	// KeepGoing keeps building the independent targets after a failure, and summarizes all
+	// the failures at the end of the build.
+	KeepGoing bool
+	// EventsFile is the path of the file to write the build events to, as JSON lines, if any.
+	EventsFile string
+	// JUnitReport is the path of the file to write a JUnit XML report of the build to, if any.
+	JUnitReport string
+	// MarkdownReport is the path of the file to write a Markdown summary of the build to, if any.
+	MarkdownReport string
+	// Interactive shows a live view of the running targets instead of their interleaved output.
+	// Only meant for terminals.
+	Interactive bool
+	// LogDir is the dir to write the log of each target to, along with a combined log, if any.
+	LogDir string
 }
 
 // BuildOpt is a collection of build options.
@@ -62,8 +77,23 @@ type BuildOpt struct {
 	Push                  bool
 	NoOutput              bool
 	OnlyFinalTargetImages bool
-	OnlyArtifact          *domain.Artifact
-	OnlyArtifactDestPath  string
+	// OnlyArtifacts are the artifacts to output in artifact mode, one for each target built.
+	OnlyArtifacts []ArtifactOutput
+	// DryRun converts the target without building it, and prints what the build would produce.
+	DryRun bool
+	// ExportLLB is the path of the file to write the LLB definition of the target to, if any. The
+	// target is then converted without being built, as in a dry run.
+	ExportLLB string
+	// ExportLLBFormat is the format of the exported LLB, as one of the LLBFormat* constants.
+	ExportLLBFormat string
+	// ExportLLBAll exports the LLB definition of every target, rather than only the final one.
+	ExportLLBAll bool
+}
+
+// ArtifactOutput is an artifact to output, along with its local destination.
+type ArtifactOutput struct {
+	Artifact domain.Artifact
+	DestPath string
 }
 
 // Builder executes Earthly builds.
@@ -75,9 +105,36 @@ type Builder struct {
 
 // NewBuilder returns a new earthly Builder.
 func NewBuilder(ctx context.Context, opt Opt) (*Builder, error) {
+	var events *eventLogger
+	if opt.EventsFile != "" {
+		var err error
+		events, err = newEventLogger(opt.EventsFile)
+		if err != nil {
+			return nil, err
+		}
+		opt.CleanCollection.Add(events.Close)
+	}
+	var ui *progressUI
+	if opt.Interactive {
+		ui = newProgressUI(os.Stdout)
+		opt.Console = opt.Console.WithWriters(ui.writer(os.Stdout), ui.writer(os.Stderr))
+	}
+	var ld *logDir
+	if opt.LogDir != "" {
+		var err error
+		ld, err = newLogDir(opt.LogDir, opt.Console)
+		if err != nil {
+			return nil, err
+		}
+		opt.CleanCollection.Add(ld.Close)
+	}
+	reports := reportFiles{
+		junit:    opt.JUnitReport,
+		markdown: opt.MarkdownReport,
+	}
 	b := &Builder{
 		s: &solver{
-			sm:              newSolverMonitor(opt.Console, opt.Verbose),
+			sm:              newSolverMonitor(opt.Console, opt.Verbose, opt.KeepGoing, events, reports, ui, ld),
 			bkClient:        opt.BkClient,
 			cacheImports:    opt.CacheImports,
 			cacheExport:     opt.CacheExport,
@@ -95,11 +152,22 @@ func NewBuilder(ctx context.Context, opt Opt) (*Builder, error) {
 
 // BuildTarget executes the build of a given Earthly target.
 func (b *Builder) BuildTarget(ctx context.Context, target domain.Target, opt BuildOpt) (*states.MultiTarget, error) {
-	mts, err := b.convertAndBuild(ctx, target, opt)
+	mtss, err := b.BuildTargets(ctx, []domain.Target{target}, opt)
+	if err != nil {
+		return nil, err
+	}
+	return mtss[0], nil
+}
+
+// BuildTargets executes the builds of the given Earthly targets within a single session, so
+// that the dependencies they have in common are converted and built only once. In artifact
+// mode, the targets are those of opt.OnlyArtifacts.
+func (b *Builder) BuildTargets(ctx context.Context, targets []domain.Target, opt BuildOpt) ([]*states.MultiTarget, error) {
+	mtss, err := b.convertAndBuild(ctx, targets, opt)
 	if err != nil {
 		return nil, err
 	}
-	return mts, nil
+	return mtss, nil
 }
 
 // MakeImageAsTarBuilderFun returns a function which can be used to build an image as a tar.
@@ -109,7 +177,10 @@ func (b *Builder) MakeImageAsTarBuilderFun() states.DockerBuilderFun {
 	}
 }
 
-func (b *Builder) convertAndBuild(ctx context.Context, target domain.Target, opt BuildOpt) (*states.MultiTarget, error) {
+func (b *Builder) convertAndBuild(ctx context.Context, targets []domain.Target, opt BuildOpt) ([]*states.MultiTarget, error) {
+	// The build is made of several solves (including nested ones, for WITH DOCKER --load):
+	// its summary is only emitted once they are all done.
+	defer b.s.sm.Finish()
 	outDir, err := ioutil.TempDir(".", ".tmp-earthly-out")
 	if err != nil {
 		return nil, errors.Wrap(err, "mk temp dir for artifacts")
@@ -123,47 +194,95 @@ func (b *Builder) convertAndBuild(ctx context.Context, target domain.Target, opt
 	}
 	destPathWhitelist := make(map[string]bool)
 	manifestLists := make(map[string][]manifest) // parent image -> child images
-	var mts *states.MultiTarget
+	mtss := make([]*states.MultiTarget, len(targets))
+	// The index of the output dir of each artifact of opt.OnlyArtifacts.
+	artifactDirIndexes := make([]int, len(opt.OnlyArtifacts))
+	// Exporting the LLB only needs the conversion, just like a dry run. Neither builds anything,
+	// nor has any side effect on the host.
+	convertOnly := opt.DryRun || opt.ExportLLB != ""
 	bf := func(ctx context.Context, gwClient gwclient.Client) (*gwclient.Result, error) {
-		var err error
-		mts, err = earthfile2llb.Earthfile2LLB(ctx, target, earthfile2llb.ConvertOpt{
-			GwClient:             gwClient,
-			Resolver:             b.resolver,
-			ImageResolveMode:     b.opt.ImageResolveMode,
-			DockerBuilderFun:     b.MakeImageAsTarBuilderFun(),
-			CleanCollection:      b.opt.CleanCollection,
-			Platform:             opt.Platform,
-			VarCollection:        b.opt.VarCollection,
-			BuildContextProvider: b.opt.BuildContextProvider,
-			CacheImports:         b.opt.CacheImports,
-			UseInlineCache:       b.opt.UseInlineCache,
-			UseFakeDep:           b.opt.UseFakeDep,
-		})
-		if err != nil {
-			return nil, err
+		// Shared by all the targets, for deduplicating their common dependencies.
+		visited := states.NewVisitedCollection()
+		solveCache := states.NewSolveCache()
+		for i, target := range targets {
+			var err error
+			mtss[i], err = earthfile2llb.Earthfile2LLB(ctx, target, earthfile2llb.ConvertOpt{
+				GwClient:             gwClient,
+				Resolver:             b.resolver,
+				ImageResolveMode:     b.opt.ImageResolveMode,
+				DockerBuilderFun:     b.MakeImageAsTarBuilderFun(),
+				CleanCollection:      b.opt.CleanCollection,
+				Visited:              visited,
+				Platform:             opt.Platform,
+				VarCollection:        b.opt.VarCollection,
+				SolveCache:           solveCache,
+				BuildContextProvider: b.opt.BuildContextProvider,
+				CacheImports:         b.opt.CacheImports,
+				UseInlineCache:       b.opt.UseInlineCache,
+				UseFakeDep:           b.opt.UseFakeDep,
+				Console:              b.opt.Console,
+				DryRun:               convertOnly,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if opt.ExportLLB != "" {
+			err := b.exportLLB(ctx, mtss, opt)
+			if err != nil {
+				return nil, errors.Wrap(err, "export llb")
+			}
 		}
 		res := gwclient.NewResult()
-		ref, err := b.stateToRef(ctx, gwClient, mts.Final.MainState, mts.Final.Platform)
-		if err != nil {
-			return nil, err
+		if convertOnly {
+			return res, nil
 		}
-		res.AddRef("main", ref)
-		if !opt.NoOutput && opt.OnlyArtifact != nil && !opt.OnlyFinalTargetImages {
-			ref, err = b.stateToRef(ctx, gwClient, mts.Final.ArtifactsState, mts.Final.Platform)
+		if b.opt.KeepGoing {
+			// The targets share the visited collection, hence all the states are listed by
+			// any of them.
+			err := b.evaluateAll(ctx, gwClient, mtss[0].All())
 			if err != nil {
 				return nil, err
 			}
-			refKey := "final-artifact"
-			refPrefix := fmt.Sprintf("ref/%s", refKey)
+		}
+		for i, mts := range mtss {
+			ref, err := b.stateToRef(ctx, gwClient, mts.Final.MainState, mts.Final.Platform)
+			if err != nil {
+				return nil, err
+			}
+			refKey := "main"
+			if i > 0 {
+				refKey = fmt.Sprintf("main-%d", i)
+			}
 			res.AddRef(refKey, ref)
-			res.AddMeta(fmt.Sprintf("%s/export-dir", refPrefix), []byte("true"))
-			res.AddMeta(fmt.Sprintf("%s/final-artifact", refPrefix), []byte("true"))
 		}
 
 		depIndex := 0
 		imageIndex := 0
 		dirIndex := 0
-		for _, sts := range mts.All() {
+		if !opt.NoOutput && !opt.OnlyFinalTargetImages {
+			for i, ao := range opt.OnlyArtifacts {
+				mts := mtss[i]
+				ref, err := b.stateToRef(ctx, gwClient, mts.Final.ArtifactsState, mts.Final.Platform)
+				if err != nil {
+					return nil, err
+				}
+				refKey := fmt.Sprintf("dir-%d", dirIndex)
+				refPrefix := fmt.Sprintf("ref/%s", refKey)
+				res.AddRef(refKey, ref)
+				res.AddMeta(fmt.Sprintf("%s/artifact", refPrefix), []byte(ao.Artifact.String()))
+				res.AddMeta(fmt.Sprintf("%s/src-path", refPrefix), []byte(ao.Artifact.Artifact))
+				res.AddMeta(fmt.Sprintf("%s/dest-path", refPrefix), []byte(ao.DestPath))
+				res.AddMeta(fmt.Sprintf("%s/export-dir", refPrefix), []byte("true"))
+				res.AddMeta(fmt.Sprintf("%s/dir-index", refPrefix), []byte(fmt.Sprintf("%d", dirIndex)))
+				destPathWhitelist[ao.DestPath] = true
+				artifactDirIndexes[i] = dirIndex
+				dirIndex++
+			}
+		}
+		// The targets share the visited collection, hence all the states are listed by any
+		// of them.
+		for _, sts := range mtss[0].All() {
 			if sts.HasDangling && !b.opt.UseFakeDep {
 				depRef, err := b.stateToRef(ctx, gwClient, sts.MainState, sts.Platform)
 				if err != nil {
@@ -176,7 +295,7 @@ func (b *Builder) convertAndBuild(ctx context.Context, target domain.Target, opt
 
 			for _, saveImage := range sts.SaveImages {
 				shouldPush := opt.Push && saveImage.Push && !sts.Target.IsRemote()
-				shouldExport := !opt.NoOutput && opt.OnlyArtifact == nil && !(opt.OnlyFinalTargetImages && sts != mts.Final)
+				shouldExport := !opt.NoOutput && len(opt.OnlyArtifacts) == 0 && !(opt.OnlyFinalTargetImages && !isFinal(mtss, sts))
 				useCacheHint := saveImage.CacheHint && b.opt.CacheExport != ""
 				if !shouldPush && !shouldExport && !useCacheHint {
 					// Short-circuit.
@@ -255,7 +374,7 @@ func (b *Builder) convertAndBuild(ctx context.Context, target domain.Target, opt
 					}
 				}
 			}
-			if !sts.Target.IsRemote() && !opt.NoOutput && !opt.OnlyFinalTargetImages && opt.OnlyArtifact == nil {
+			if !sts.Target.IsRemote() && !opt.NoOutput && !opt.OnlyFinalTargetImages && len(opt.OnlyArtifacts) == 0 {
 				for _, saveLocal := range sts.SaveLocals {
 					ref, err := b.stateToRef(ctx, gwClient, sts.SeparateArtifactsState[saveLocal.Index], sts.Platform)
 					if err != nil {
@@ -305,40 +424,51 @@ func (b *Builder) convertAndBuild(ctx context.Context, target domain.Target, opt
 		}
 		return artifactDir, nil
 	}
-	onFinalArtifact := func(ctx context.Context) (string, error) {
-		successOnce.Do(successFun)
-		return outDir, nil
+	if convertOnly {
+		err = b.s.convertOnly(ctx, bf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "convert")
+		}
+		if opt.DryRun {
+			printPlan(b.opt.Console, mtss, opt)
+		}
+		return mtss, nil
 	}
-	err = b.s.buildMainMulti(ctx, bf, onImage, onArtifact, onFinalArtifact)
+	err = b.s.buildMainMulti(ctx, bf, onImage, onArtifact)
 	if err != nil {
 		return nil, errors.Wrapf(err, "build main")
 	}
 	successOnce.Do(successFun)
 	if opt.NoOutput {
 		// Nothing.
-	} else if opt.OnlyArtifact != nil {
-		err := b.saveArtifactLocally(ctx, *opt.OnlyArtifact, outDir, opt.OnlyArtifactDestPath, mts.Final.Salt, opt, false)
-		if err != nil {
-			return nil, err
+	} else if len(opt.OnlyArtifacts) > 0 {
+		for i, ao := range opt.OnlyArtifacts {
+			artifactDir := filepath.Join(outDir, fmt.Sprintf("index-%d", artifactDirIndexes[i]))
+			err := b.saveArtifactLocally(ctx, ao.Artifact, artifactDir, ao.DestPath, mtss[i].Final.Salt, opt, false)
+			if err != nil {
+				return nil, err
+			}
 		}
 	} else if opt.OnlyFinalTargetImages {
-		for _, saveImage := range mts.Final.SaveImages {
-			shouldPush := opt.Push && saveImage.Push
-			console := b.opt.Console.WithPrefixAndSalt(mts.Final.Target.String(), mts.Final.Salt)
-			pushStr := ""
-			if shouldPush {
-				pushStr = " (pushed)"
-			}
-			console.Printf("Image %s as %s%s\n", mts.Final.Target.StringCanonical(), saveImage.DockerTag, pushStr)
-			if saveImage.Push && !opt.Push {
-				console.Printf("Did not push %s. Use earthly --push to enable pushing\n", saveImage.DockerTag)
+		for _, mts := range mtss {
+			for _, saveImage := range mts.Final.SaveImages {
+				shouldPush := opt.Push && saveImage.Push
+				console := b.opt.Console.WithPrefixAndSalt(mts.Final.Target.String(), mts.Final.Salt)
+				pushStr := ""
+				if shouldPush {
+					pushStr = " (pushed)"
+				}
+				console.Printf("Image %s as %s%s\n", mts.Final.Target.StringCanonical(), saveImage.DockerTag, pushStr)
+				if saveImage.Push && !opt.Push {
+					console.Printf("Did not push %s. Use earthly --push to enable pushing\n", saveImage.DockerTag)
+				}
 			}
 		}
 	} else {
 		// This needs to match with the same index used during output.
 		// TODO: This is a little brittle to future code changes.
 		dirIndex := 0
-		for _, sts := range mts.All() {
+		for _, sts := range mtss[0].All() {
 			for _, saveImage := range sts.SaveImages {
 				shouldPush := opt.Push && saveImage.Push && !sts.Target.IsRemote()
 				console := b.opt.Console.WithPrefixAndSalt(sts.Target.String(), sts.Salt)
@@ -378,7 +508,86 @@ func (b *Builder) convertAndBuild(ctx context.Context, target domain.Target, opt
 		}
 	}
 
-	return mts, nil
+	return mtss, nil
+}
+
+// evaluateAll builds the given targets concurrently. Unlike the lazy solve of the result refs,
+// which aborts at the first failure, a failing target only stops the targets depending on it.
+// The errors of all the failed targets are returned.
+func (b *Builder) evaluateAll(ctx context.Context, gwClient gwclient.Client, stss []*states.SingleTarget) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var errs multiError
+	for _, sts := range stss {
+		sts := sts
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := b.evaluateTarget(ctx, gwClient, sts)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, errors.Wrapf(err, "build %s", sts.Target.String()))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(errs) > 0 {
+		// The order of the targets is not deterministic, since they fail concurrently.
+		sort.Slice(errs, func(i, j int) bool {
+			return errs[i].Error() < errs[j].Error()
+		})
+		return errs
+	}
+	return nil
+}
+
+// evaluateTarget builds the main state of the target, followed by the states of its
+// SAVE ARTIFACT and SAVE IMAGE commands.
+func (b *Builder) evaluateTarget(ctx context.Context, gwClient gwclient.Client, sts *states.SingleTarget) error {
+	toEvaluate := []llb.State{sts.MainState}
+	for _, state := range append([]llb.State{sts.ArtifactsState}, sts.SeparateArtifactsState...) {
+		if state.Output() == nil {
+			// Scratch - no artifact has been saved.
+			continue
+		}
+		toEvaluate = append(toEvaluate, state)
+	}
+	for _, saveImage := range sts.SaveImages {
+		toEvaluate = append(toEvaluate, saveImage.State)
+	}
+	for _, state := range toEvaluate {
+		if b.opt.NoCache {
+			state = state.SetMarshalDefaults(llb.IgnoreCache)
+		}
+		err := llbutil.EvaluateState(ctx, gwClient, state, sts.Platform, b.opt.CacheImports)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// multiError is the collection of the errors of several operations, which failed
+// independently of each other.
+type multiError []error
+
+func (me multiError) Error() string {
+	msgs := make([]string, 0, len(me))
+	for _, err := range me {
+		msgs = append(msgs, fmt.Sprintf("* %s", err.Error()))
+	}
+	return fmt.Sprintf("%d errors occurred:\n%s", len(me), strings.Join(msgs, "\n"))
+}
+
+// isFinal returns whether the state is the final state of one of the targets.
+func isFinal(mtss []*states.MultiTarget, sts *states.SingleTarget) bool {
+	for _, mts := range mtss {
+		if sts == mts.Final {
+			return true
+		}
+	}
+	return false
 }
 
 func (b *Builder) stateToRef(ctx context.Context, gwClient gwclient.Client, state llb.State, platform *specs.Platform) (gwclient.Reference, error) {
@@ -0,0 +1,104 @@
+package builder
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// The types of the build events.
+const (
+	eventVertexStarted   = "vertex-started"
+	eventVertexCompleted = "vertex-completed"
+	eventVertexCached    = "vertex-cached"
+	eventVertexError     = "vertex-error"
+	eventVertexCanceled  = "vertex-canceled"
+	eventLog             = "log"
+	eventProgress        = "progress"
+	eventBuildSummary    = "summary"
+)
+
+// buildEvent is a build event, as written to the events file. The vertex events, the log
+// events and the progress events are keyed by the target, the salt and the digest of the
+// vertex.
+type buildEvent struct {
+	Time      time.Time     `json:"time"`
+	Type      string        `json:"type"`
+	Target    string        `json:"target,omitempty"`
+	Salt      string        `json:"salt,omitempty"`
+	Digest    string        `json:"digest,omitempty"`
+	Operation string        `json:"operation,omitempty"`
+	Error     string        `json:"error,omitempty"`
+	Duration  float64       `json:"duration,omitempty"`
+	Stream    int           `json:"stream,omitempty"`
+	Data      string        `json:"data,omitempty"`
+	ID        string        `json:"id,omitempty"`
+	Current   int64         `json:"current,omitempty"`
+	Total     int64         `json:"total,omitempty"`
+	Summary   *eventSummary `json:"summary,omitempty"`
+}
+
+// eventSummary is the summary of a build, written as the last event of the build.
+type eventSummary struct {
+	Vertices int            `json:"vertices"`
+	Cached   int            `json:"cached"`
+	Failures []eventFailure `json:"failures"`
+	Duration float64        `json:"duration"`
+}
+
+type eventFailure struct {
+	Target    string `json:"target"`
+	Salt      string `json:"salt"`
+	Digest    string `json:"digest"`
+	Operation string `json:"operation"`
+	Error     string `json:"error"`
+}
+
+// eventLogger writes build events to a file, as JSON lines.
+type eventLogger struct {
+	mu  sync.Mutex
+	f   *os.File
+	enc *json.Encoder
+	err error
+}
+
+func newEventLogger(path string) (*eventLogger, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create events file %s", path)
+	}
+	return &eventLogger{
+		f:   f,
+		enc: json.NewEncoder(f),
+	}, nil
+}
+
+// log writes an event. Write errors do not fail the build: the first one is returned by Close.
+func (el *eventLogger) log(ev buildEvent) {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	if el.err != nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	err := el.enc.Encode(ev)
+	if err != nil {
+		el.err = errors.Wrapf(err, "write events file %s", el.f.Name())
+	}
+}
+
+// Close closes the events file.
+func (el *eventLogger) Close() error {
+	el.mu.Lock()
+	defer el.mu.Unlock()
+	err := el.f.Close()
+	if el.err != nil {
+		return el.err
+	}
+	return err
+}
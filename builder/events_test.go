@@ -0,0 +1,116 @@
+package builder
+
+import (
+	"bufio"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/moby/buildkit/client"
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLogVertexEvents(t *testing.T) {
+	started := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	completed := started.Add(1500 * time.Millisecond)
+	var tests = []struct {
+		name     string
+		updates  []client.Vertex
+		expected []string
+	}{
+		{
+			"completed",
+			[]client.Vertex{
+				{Started: &started},
+				{Started: &started},
+				{Started: &started, Completed: &completed},
+			},
+			[]string{eventVertexStarted, eventVertexCompleted},
+		},
+		{
+			"cached",
+			[]client.Vertex{
+				{Cached: true},
+				{Cached: true, Completed: &completed},
+			},
+			[]string{eventVertexCached},
+		},
+		{
+			"error",
+			[]client.Vertex{
+				{Started: &started},
+				{Started: &started, Completed: &completed, Error: "executor failed running [/bin/sh -c false]: exit code: 1"},
+			},
+			[]string{eventVertexStarted, eventVertexError},
+		},
+		{
+			"error before start",
+			[]client.Vertex{
+				{Error: "failed to compute cache key"},
+			},
+			[]string{eventVertexError},
+		},
+		{
+			"canceled",
+			[]client.Vertex{
+				{Started: &started},
+				{Started: &started, Completed: &completed, Error: "context canceled"},
+			},
+			[]string{eventVertexStarted, eventVertexCanceled},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "earthly-events")
+			if !NoError(t, err) {
+				return
+			}
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "events.jsonl")
+			events, err := newEventLogger(path)
+			if !NoError(t, err) {
+				return
+			}
+			sm := &solverMonitor{events: events}
+			vm := &vertexMonitor{targetStr: "+test", salt: "abc", operation: "RUN false"}
+			for _, update := range tt.updates {
+				update := update
+				update.Digest = "sha256:0123"
+				vm.vertex = &update
+				sm.logVertexEvents(vm)
+			}
+			NoError(t, events.Close())
+
+			f, err := os.Open(path)
+			if !NoError(t, err) {
+				return
+			}
+			defer f.Close()
+			var actual []string
+			scanner := bufio.NewScanner(f)
+			for scanner.Scan() {
+				var ev buildEvent
+				NoError(t, json.Unmarshal(scanner.Bytes(), &ev))
+				Equal(t, "+test", ev.Target)
+				Equal(t, "abc", ev.Salt)
+				Equal(t, "sha256:0123", ev.Digest)
+				Equal(t, "RUN false", ev.Operation)
+				switch ev.Type {
+				case eventVertexStarted:
+					Equal(t, started, ev.Time.UTC())
+				case eventVertexCompleted, eventVertexError, eventVertexCanceled:
+					if tt.updates[len(tt.updates)-1].Completed != nil {
+						Equal(t, 1.5, ev.Duration)
+					}
+					Equal(t, tt.updates[len(tt.updates)-1].Error, ev.Error)
+				}
+				actual = append(actual, ev.Type)
+			}
+			NoError(t, scanner.Err())
+			Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package builder
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/earthly/earthly/llbutil"
+	"github.com/earthly/earthly/states"
+	"github.com/moby/buildkit/client/llb"
+	"github.com/moby/buildkit/solver/pb"
+	digest "github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+// The formats of the exported LLB.
+const (
+	// LLBFormatPB is the protobuf Definition of the final target, as expected by
+	// buildctl build and buildctl debug dump-llb.
+	LLBFormatPB = "pb"
+	// LLBFormatJSON is a human-readable dump of the operations of each target, similar to the
+	// output of buildctl debug dump-llb.
+	LLBFormatJSON = "json"
+)
+
+// llbTarget is the JSON dump of the LLB of a target.
+type llbTarget struct {
+	Target   string  `json:"target"`
+	Platform string  `json:"platform"`
+	Ops      []llbOp `json:"ops"`
+}
+
+type llbOp struct {
+	Op         pb.Op
+	Digest     digest.Digest
+	OpMetadata pb.OpMetadata
+}
+
+// exportLLB writes the LLB definition of the final targets (or of every target, if
+// opt.ExportLLBAll is set) to the file opt.ExportLLB.
+func (b *Builder) exportLLB(ctx context.Context, mtss []*states.MultiTarget, opt BuildOpt) error {
+	// Validate the options before creating the file, so that no empty file is left behind.
+	switch opt.ExportLLBFormat {
+	case LLBFormatPB:
+		if opt.ExportLLBAll {
+			return fmt.Errorf("exporting the LLB of every target is not supported by the %s format", LLBFormatPB)
+		}
+		if len(mtss) != 1 {
+			return fmt.Errorf("exporting the LLB of several targets is not supported by the %s format", LLBFormatPB)
+		}
+	case LLBFormatJSON:
+	default:
+		return fmt.Errorf("invalid LLB format %s", opt.ExportLLBFormat)
+	}
+	f, err := os.Create(opt.ExportLLB)
+	if err != nil {
+		return errors.Wrapf(err, "create %s", opt.ExportLLB)
+	}
+	defer f.Close()
+	switch opt.ExportLLBFormat {
+	case LLBFormatPB:
+		mts := mtss[0]
+		def, err := b.marshalState(ctx, mts.Final.MainState, mts.Final)
+		if err != nil {
+			return err
+		}
+		err = llb.WriteTo(def, f)
+		if err != nil {
+			return errors.Wrapf(err, "write %s", opt.ExportLLB)
+		}
+	case LLBFormatJSON:
+		var targets []*states.SingleTarget
+		for _, mts := range mtss {
+			targets = append(targets, mts.Final)
+		}
+		if opt.ExportLLBAll {
+			// The targets share the visited collection, hence all the states are listed by
+			// any of them.
+			targets = mtss[0].All()
+		}
+		var dump []llbTarget
+		for _, sts := range targets {
+			def, err := b.marshalState(ctx, sts.MainState, sts)
+			if err != nil {
+				return err
+			}
+			lt := llbTarget{
+				Target:   sts.TargetInput.TargetCanonical,
+				Platform: llbutil.PlatformToString(sts.Platform),
+				Ops:      []llbOp{},
+			}
+			for _, dt := range def.Def {
+				var op pb.Op
+				err := op.Unmarshal(dt)
+				if err != nil {
+					return errors.Wrap(err, "unmarshal op")
+				}
+				dgst := digest.FromBytes(dt)
+				lt.Ops = append(lt.Ops, llbOp{Op: op, Digest: dgst, OpMetadata: def.Metadata[dgst]})
+			}
+			dump = append(dump, lt)
+		}
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(dump)
+		if err != nil {
+			return errors.Wrapf(err, "write %s", opt.ExportLLB)
+		}
+	}
+	return nil
+}
+
+func (b *Builder) marshalState(ctx context.Context, state llb.State, sts *states.SingleTarget) (*llb.Definition, error) {
+	if b.opt.NoCache {
+		state = state.SetMarshalDefaults(llb.IgnoreCache)
+	}
+	def, err := state.Marshal(ctx, llb.Platform(llbutil.PlatformWithDefault(sts.Platform)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal state of %s", sts.Target.String())
+	}
+	return def, nil
+}
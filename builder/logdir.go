@@ -0,0 +1,214 @@
+package builder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/earthly/earthly/conslogging"
+	"github.com/pkg/errors"
+)
+
+// combinedLogFile is the name of the log of the whole build, within the log dir.
+const combinedLogFile = "combined.log"
+
+// logDir writes the output of each target to its own file within a dir, along with a combined
+// log of the whole build, where each line is prefixed with its time and target.
+type logDir struct {
+	mu       sync.Mutex
+	console  conslogging.ConsoleLogger
+	dir      string
+	combined *os.File
+	targets  map[timingKey]*targetLog
+	names    map[string]bool
+	err      error
+}
+
+type targetLog struct {
+	f          *os.File
+	path       string
+	name       string
+	partial    string
+	headerDone map[*vertexMonitor]bool
+	errorDone  map[*vertexMonitor]bool
+}
+
+func newLogDir(dir string, console conslogging.ConsoleLogger) (*logDir, error) {
+	err := os.MkdirAll(filepath.Join(dir, "targets"), 0755)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create log dir %s", dir)
+	}
+	combinedPath := filepath.Join(dir, combinedLogFile)
+	combined, err := os.Create(combinedPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create %s", combinedPath)
+	}
+	return &logDir{
+		console:  console,
+		dir:      dir,
+		combined: combined,
+		targets:  make(map[timingKey]*targetLog),
+		names:    make(map[string]bool),
+	}, nil
+}
+
+var unsafeFileNameRegexp = regexp.MustCompile("[^A-Za-z0-9+._-]+")
+
+// target returns the log of the target of the vertex, creating its file if needed.
+// Assumes mu locked.
+func (ld *logDir) target(vm *vertexMonitor) (*targetLog, error) {
+	key := timingKey{
+		targetStr:      vm.targetStr,
+		targetBrackets: vm.targetBrackets,
+		salt:           vm.salt,
+	}
+	tl, ok := ld.targets[key]
+	if ok {
+		return tl, nil
+	}
+	name := vm.targetStr
+	if vm.targetBrackets != "" {
+		name = fmt.Sprintf("%s (%s)", vm.targetStr, vm.targetBrackets)
+	}
+	// The same target built with different args gets a file of its own, numbered.
+	base := strings.Trim(unsafeFileNameRegexp.ReplaceAllString(vm.targetStr, "_"), "._")
+	if base == "" {
+		base = "target"
+	}
+	fileName := base
+	for i := 2; ld.names[fileName]; i++ {
+		fileName = fmt.Sprintf("%s-%d", base, i)
+	}
+	ld.names[fileName] = true
+	path := filepath.Join(ld.dir, "targets", fileName+".log")
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create %s", path)
+	}
+	tl = &targetLog{
+		f:          f,
+		path:       path,
+		name:       name,
+		headerDone: make(map[*vertexMonitor]bool),
+		errorDone:  make(map[*vertexMonitor]bool),
+	}
+	ld.targets[key] = tl
+	return tl, nil
+}
+
+// vertex records the start or the failure of a vertex.
+func (ld *logDir) vertex(vm *vertexMonitor) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if ld.err != nil {
+		return
+	}
+	vertex := vm.vertex
+	var lines []string
+	tl, ok := ld.targets[timingKey{targetStr: vm.targetStr, targetBrackets: vm.targetBrackets, salt: vm.salt}]
+	headerDone := ok && tl.headerDone[vm]
+	errorDone := ok && tl.errorDone[vm]
+	if !headerDone && (vertex.Cached || vertex.Started != nil || vertex.Error != "") {
+		if vertex.Cached {
+			lines = append(lines, fmt.Sprintf("--> %s (cached)", vm.operation))
+		} else {
+			lines = append(lines, fmt.Sprintf("--> %s", vm.operation))
+		}
+	}
+	if vm.isFailed && !errorDone {
+		lines = append(lines, fmt.Sprintf("ERROR: %s: %s", vm.operation, failureReason(vertex.Error)))
+	}
+	if len(lines) == 0 {
+		return
+	}
+	tl, err := ld.target(vm)
+	if err != nil {
+		ld.fail(err)
+		return
+	}
+	tl.headerDone[vm] = true
+	tl.errorDone[vm] = vm.isFailed
+	ld.writeLines(tl, time.Now(), lines)
+}
+
+// log records a chunk of the output of a vertex.
+func (ld *logDir) log(vm *vertexMonitor, timestamp time.Time, data []byte) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	if ld.err != nil {
+		return
+	}
+	tl, err := ld.target(vm)
+	if err != nil {
+		ld.fail(err)
+		return
+	}
+	lines := strings.Split(tl.partial+stripANSI(string(data)), "\n")
+	tl.partial = lines[len(lines)-1]
+	ld.writeLines(tl, timestamp, lines[:len(lines)-1])
+}
+
+// writeLines writes lines to the log of the target and to the combined log. Assumes mu locked.
+func (ld *logDir) writeLines(tl *targetLog, timestamp time.Time, lines []string) {
+	for _, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+		_, err := fmt.Fprintf(tl.f, "%s\n", line)
+		if err != nil {
+			ld.fail(errors.Wrapf(err, "write %s", tl.path))
+			return
+		}
+		_, err = fmt.Fprintf(ld.combined, "%s %s | %s\n", timestamp.UTC().Format(time.RFC3339Nano), tl.name, line)
+		if err != nil {
+			ld.fail(errors.Wrapf(err, "write %s", ld.combined.Name()))
+			return
+		}
+	}
+}
+
+// fail stops the writing of the logs after the first error, which does not fail the build.
+// Assumes mu locked.
+func (ld *logDir) fail(err error) {
+	ld.err = err
+	ld.console.Warnf("Error: %v. The logs in %s are incomplete\n", err, ld.dir)
+}
+
+// pathOf returns the path of the log file of the target of the vertex, if any.
+func (ld *logDir) pathOf(vm *vertexMonitor) (string, bool) {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	tl, ok := ld.targets[timingKey{targetStr: vm.targetStr, targetBrackets: vm.targetBrackets, salt: vm.salt}]
+	if !ok {
+		return "", false
+	}
+	return tl.path, true
+}
+
+// Close flushes the lines which are not terminated yet and closes the log files.
+func (ld *logDir) Close() error {
+	ld.mu.Lock()
+	defer ld.mu.Unlock()
+	tls := make([]*targetLog, 0, len(ld.targets))
+	for _, tl := range ld.targets {
+		tls = append(tls, tl)
+	}
+	sort.Slice(tls, func(i, j int) bool {
+		return tls[i].path < tls[j].path
+	})
+	now := time.Now()
+	for _, tl := range tls {
+		if tl.partial != "" && ld.err == nil {
+			ld.writeLines(tl, now, []string{tl.partial})
+		}
+		tl.f.Close()
+	}
+	err := ld.combined.Close()
+	if ld.err != nil {
+		return ld.err
+	}
+	return err
+}
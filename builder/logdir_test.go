@@ -0,0 +1,115 @@
+package builder
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/earthly/earthly/conslogging"
+	"github.com/moby/buildkit/client"
+	. "github.com/stretchr/testify/assert"
+)
+
+func testLogDir(t *testing.T) (*logDir, *bytes.Buffer, func()) {
+	dir, err := ioutil.TempDir("", "earthly-log-dir")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	console := conslogging.Current(conslogging.NoColor, conslogging.NoPadding).WithWriters(&out, &out)
+	ld, err := newLogDir(dir, console)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return ld, &out, func() { os.RemoveAll(dir) }
+}
+
+func testLogVertex(targetStr, targetBrackets, salt, operation string) *vertexMonitor {
+	started := time.Now()
+	return &vertexMonitor{
+		vertex:         &client.Vertex{Started: &started},
+		targetStr:      targetStr,
+		targetBrackets: targetBrackets,
+		salt:           salt,
+		operation:      operation,
+	}
+}
+
+func TestLogDir(t *testing.T) {
+	ld, _, cleanup := testLogDir(t)
+	defer cleanup()
+	build := testLogVertex("+build", "", "a", "RUN make")
+	buildWithArgs := testLogVertex("+build", "VERSION=2", "b", "RUN make")
+	remote := testLogVertex("github.com/foo/bar:v1+lib", "", "c", "RUN ./configure")
+
+	ld.vertex(build)
+	ld.log(build, time.Now(), []byte("compiling\nhal"))
+	ld.vertex(buildWithArgs)
+	ld.log(buildWithArgs, time.Now(), []byte("\x1b[32mok\x1b[0m\r\n"))
+	ld.vertex(remote)
+	ld.log(build, time.Now(), []byte("f"))
+	build.isFailed = true
+	build.vertex.Error = "executor failed running [/bin/sh -c make]: exit code: 2"
+	ld.vertex(build)
+	ld.vertex(build)
+	NoError(t, ld.Close())
+
+	var tests = []struct {
+		file     string
+		expected []string
+	}{
+		{"+build.log", []string{"--> RUN make", "compiling", "ERROR: RUN make: exit code 2", "half"}},
+		{"+build-2.log", []string{"--> RUN make", "ok"}},
+		{"github.com_foo_bar_v1+lib.log", []string{"--> RUN ./configure"}},
+	}
+	for _, tt := range tests {
+		dt, err := ioutil.ReadFile(filepath.Join(ld.dir, "targets", tt.file))
+		if NoError(t, err) {
+			Equal(t, strings.Join(tt.expected, "\n")+"\n", string(dt))
+		}
+	}
+	dt, err := ioutil.ReadFile(filepath.Join(ld.dir, combinedLogFile))
+	if !NoError(t, err) {
+		return
+	}
+	var actual []string
+	for _, line := range strings.Split(strings.TrimSuffix(string(dt), "\n"), "\n") {
+		// Strip the time.
+		parts := strings.SplitN(line, " ", 2)
+		if Len(t, parts, 2) {
+			_, err := time.Parse(time.RFC3339Nano, parts[0])
+			NoError(t, err)
+			actual = append(actual, parts[1])
+		}
+	}
+	Equal(t, []string{
+		"+build | --> RUN make",
+		"+build | compiling",
+		"+build (VERSION=2) | --> RUN make",
+		"+build (VERSION=2) | ok",
+		"github.com/foo/bar:v1+lib | --> RUN ./configure",
+		"+build | ERROR: RUN make: exit code 2",
+		"+build | half",
+	}, actual)
+
+	path, ok := ld.pathOf(buildWithArgs)
+	True(t, ok)
+	Equal(t, filepath.Join(ld.dir, "targets", "+build-2.log"), path)
+}
+
+func TestLogDirWriteError(t *testing.T) {
+	ld, out, cleanup := testLogDir(t)
+	defer cleanup()
+	vm := testLogVertex("+build", "", "a", "RUN make")
+	ld.combined.Close()
+	ld.vertex(vm)
+	ld.log(vm, time.Now(), []byte("output\n"))
+	Error(t, ld.Close())
+	Equal(t, 1, strings.Count(out.String(), "Error:"))
+	Contains(t, out.String(), combinedLogFile)
+}
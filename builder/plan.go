@@ -0,0 +1,90 @@
+package builder
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/earthly/earthly/conslogging"
+	"github.com/earthly/earthly/domain"
+	"github.com/earthly/earthly/states"
+	"github.com/earthly/earthly/states/dedup"
+)
+
+// printPlan prints what the build of the given states would produce: the targets along with
+// their build args and platforms, the images saved (and pushed), the artifacts saved locally,
+// the RUN --push commands and the commands that LOCALLY targets would execute on the host.
+func printPlan(console conslogging.ConsoleLogger, mtss []*states.MultiTarget, opt BuildOpt) {
+	console.Printf("Dry run: nothing has been built\n")
+	for _, sts := range mtss[0].All() {
+		tc := console.WithPrefixAndSalt(sts.Target.String(), sts.Salt)
+		platform := sts.TargetInput.Platform
+		if platform == "" {
+			platform = "default"
+		}
+		tc.Printf("Target %s (platform %s)\n", sts.TargetInput.TargetCanonical, platform)
+		if len(sts.TargetInput.BuildArgs) > 0 {
+			var args []string
+			for _, bai := range sts.TargetInput.BuildArgs {
+				args = append(args, buildArgString(bai))
+			}
+			tc.Printf("Build args: %s\n", strings.Join(args, " "))
+		}
+		for _, commandStr := range sts.LocallyCommandStrs {
+			tc.Printf("Would execute locally %s\n", commandStr)
+		}
+		if opt.NoOutput && !opt.Push {
+			continue
+		}
+		for _, saveImage := range sts.SaveImages {
+			if saveImage.DockerTag == "" {
+				continue
+			}
+			shouldPush := opt.Push && saveImage.Push && !sts.Target.IsRemote()
+			shouldExport := !opt.NoOutput && len(opt.OnlyArtifacts) == 0 && !(opt.OnlyFinalTargetImages && !isFinal(mtss, sts))
+			switch {
+			case shouldPush && shouldExport:
+				tc.Printf("Would output image %s and push it\n", saveImage.DockerTag)
+			case shouldPush:
+				tc.Printf("Would push image %s\n", saveImage.DockerTag)
+			case shouldExport:
+				tc.Printf("Would output image %s\n", saveImage.DockerTag)
+			}
+			if saveImage.Push && !opt.Push && !sts.Target.IsRemote() {
+				tc.Printf("Would not push %s. Use earthly --push to enable pushing\n", saveImage.DockerTag)
+			}
+		}
+		if sts.Target.IsRemote() || opt.NoOutput || opt.OnlyFinalTargetImages || len(opt.OnlyArtifacts) > 0 {
+			continue
+		}
+		for _, saveLocal := range sts.SaveLocals {
+			artifact := domain.Artifact{
+				Target:   sts.Target,
+				Artifact: saveLocal.ArtifactPath,
+			}
+			tc.Printf("Would output artifact %s as local %s\n", artifact.String(), saveLocal.DestPath)
+		}
+		if !sts.RunPush.Initialized {
+			continue
+		}
+		for _, commandStr := range sts.RunPush.CommandStrs {
+			if opt.Push {
+				tc.Printf("Would execute push command %s\n", commandStr)
+			} else {
+				tc.Printf("Would not execute push command %s. Use earthly --push to enable pushing\n", commandStr)
+			}
+		}
+	}
+	if opt.NoOutput {
+		return
+	}
+	for _, ao := range opt.OnlyArtifacts {
+		console.Printf("Would output artifact %s as local %s\n", ao.Artifact.String(), ao.DestPath)
+	}
+}
+
+func buildArgString(bai dedup.BuildArgInput) string {
+	if !bai.IsConstant {
+		return fmt.Sprintf("%s=<computed>", bai.Name)
+	}
+	return fmt.Sprintf("%s=%s", bai.Name, bai.ConstantValue)
+}
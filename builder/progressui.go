@@ -0,0 +1,344 @@
+package builder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+const (
+	durationBetweenUIRedraws = 100 * time.Millisecond
+	// uiLogLines is the number of log lines shown for each running target.
+	uiLogLines = 3
+	// uiDefaultWidth and uiDefaultHeight are used when the size of the terminal is unknown.
+	uiDefaultWidth  = 80
+	uiDefaultHeight = 24
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// ansiUpLines moves the cursor up by the given number of lines, to the beginning of the line.
+const ansiUpLines = "\x1b[%dF"
+
+// ansiEraseDown erases from the cursor to the end of the screen.
+var ansiEraseDown = []byte("\x1b[J")
+
+// progressUI is a live view of the build, for terminals. It shows the running targets, each
+// with its current operation and the last few lines of its output, as well as the completed
+// targets, collapsed into a single line each. The text printed to the console while the view is
+// live is printed above it.
+type progressUI struct {
+	mu         sync.Mutex
+	out        io.Writer
+	targets    []*uiTarget
+	byKey      map[timingKey]*uiTarget
+	startTime  time.Time
+	linesDrawn int
+	frame      int
+	running    bool
+	stopCh     chan struct{}
+	doneCh     chan struct{}
+	// users is the number of solves monitored through the view. Solves may be nested (e.g. for
+	// WITH DOCKER --load), in which case the view is only stopped along with the outermost one.
+	users int
+}
+
+type uiTarget struct {
+	name      string
+	vertices  map[*vertexMonitor]bool
+	operation string
+	logLines  []string
+	partial   string
+	started   time.Time
+	completed time.Time
+	failed    bool
+	cached    bool
+}
+
+func newProgressUI(out io.Writer) *progressUI {
+	return &progressUI{
+		out:   out,
+		byKey: make(map[timingKey]*uiTarget),
+	}
+}
+
+// start shows the view and keeps it updated until stop is called, as many times as start.
+func (ui *progressUI) start() {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+	ui.users++
+	if ui.running {
+		return
+	}
+	ui.running = true
+	ui.startTime = time.Now()
+	ui.targets = nil
+	ui.byKey = make(map[timingKey]*uiTarget)
+	ui.stopCh = make(chan struct{})
+	ui.doneCh = make(chan struct{})
+	go ui.loop(ui.stopCh, ui.doneCh)
+}
+
+// stop draws the view one last time and leaves it in place, such that the console is back to
+// its plain mode.
+func (ui *progressUI) stop() {
+	ui.mu.Lock()
+	if ui.users > 0 {
+		ui.users--
+	}
+	if !ui.running || ui.users > 0 {
+		ui.mu.Unlock()
+		return
+	}
+	ui.running = false
+	close(ui.stopCh)
+	doneCh := ui.doneCh
+	ui.mu.Unlock()
+	<-doneCh
+}
+
+func (ui *progressUI) loop(stopCh, doneCh chan struct{}) {
+	defer close(doneCh)
+	ticker := time.NewTicker(durationBetweenUIRedraws)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ui.mu.Lock()
+			if ui.running {
+				ui.frame++
+				ui.redraw()
+			}
+			ui.mu.Unlock()
+		case <-stopCh:
+			ui.mu.Lock()
+			ui.redraw()
+			ui.linesDrawn = 0
+			ui.mu.Unlock()
+			return
+		}
+	}
+}
+
+func (ui *progressUI) target(vm *vertexMonitor) *uiTarget {
+	key := timingKey{
+		targetStr:      vm.targetStr,
+		targetBrackets: vm.targetBrackets,
+		salt:           vm.salt,
+	}
+	t, ok := ui.byKey[key]
+	if !ok {
+		name := vm.targetStr
+		if vm.targetBrackets != "" {
+			name = fmt.Sprintf("%s (%s)", vm.targetStr, vm.targetBrackets)
+		}
+		t = &uiTarget{
+			name:     name,
+			vertices: make(map[*vertexMonitor]bool),
+		}
+		ui.byKey[key] = t
+		ui.targets = append(ui.targets, t)
+	}
+	return t
+}
+
+// updateVertex records the state of a vertex of the build.
+func (ui *progressUI) updateVertex(vm *vertexMonitor) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+	vertex := vm.vertex
+	if vertex.Started == nil && !vertex.Cached && vertex.Error == "" {
+		return
+	}
+	t := ui.target(vm)
+	t.vertices[vm] = true
+	if vertex.Started != nil && (t.started.IsZero() || vertex.Started.Before(t.started)) {
+		t.started = *vertex.Started
+	}
+	if vertex.Completed == nil && vertex.Error == "" {
+		t.operation = vm.operation
+	}
+	if vm.isFailed {
+		t.failed = true
+	}
+	t.completed = time.Time{}
+	t.cached = true
+	for tvm := range t.vertices {
+		if tvm.vertex.Completed == nil && tvm.vertex.Error == "" {
+			// Still running.
+			return
+		}
+		if !tvm.vertex.Cached {
+			t.cached = false
+		}
+		if tvm.vertex.Completed != nil && tvm.vertex.Completed.After(t.completed) {
+			t.completed = *tvm.vertex.Completed
+		}
+	}
+	if t.completed.IsZero() {
+		t.completed = time.Now()
+	}
+}
+
+// log records output of a vertex of the build.
+func (ui *progressUI) log(vm *vertexMonitor, data []byte) {
+	ui.mu.Lock()
+	defer ui.mu.Unlock()
+	t := ui.target(vm)
+	t.vertices[vm] = true
+	lines := strings.Split(t.partial+stripANSI(string(data)), "\n")
+	t.partial = lines[len(lines)-1]
+	for _, line := range lines[:len(lines)-1] {
+		if i := strings.LastIndexByte(line, '\r'); i != -1 {
+			line = line[i+1:]
+		}
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			continue
+		}
+		t.logLines = append(t.logLines, line)
+	}
+	if len(t.logLines) > uiLogLines {
+		t.logLines = t.logLines[len(t.logLines)-uiLogLines:]
+	}
+}
+
+// clear erases the view. Assumes mu locked.
+func (ui *progressUI) clear() {
+	if ui.linesDrawn == 0 {
+		return
+	}
+	fmt.Fprintf(ui.out, ansiUpLines, ui.linesDrawn)
+	ui.out.Write(ansiEraseDown)
+	ui.linesDrawn = 0
+}
+
+// redraw replaces the view with its current state. Assumes mu locked.
+func (ui *progressUI) redraw() {
+	width, height := uiDefaultWidth, uiDefaultHeight
+	if w, h, err := terminal.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 && h > 0 {
+		width, height = w, h
+	}
+	now := time.Now()
+	var done, running []string
+	numRunning := 0
+	for _, t := range ui.targets {
+		if !t.completed.IsZero() {
+			symbol, suffix := "✔", ""
+			if t.failed {
+				symbol = "✘"
+			} else if t.cached {
+				suffix = " (cached)"
+			}
+			elapsed := time.Duration(0)
+			if !t.started.IsZero() {
+				elapsed = t.completed.Sub(t.started)
+			}
+			done = append(done, fmt.Sprintf(" %s %s %s%s", symbol, t.name, uiDuration(elapsed), suffix))
+			continue
+		}
+		numRunning++
+		elapsed := time.Duration(0)
+		if !t.started.IsZero() {
+			elapsed = now.Sub(t.started)
+		}
+		spinner := spinnerFrames[ui.frame%len(spinnerFrames)]
+		if t.failed {
+			spinner = "✘"
+		}
+		running = append(running, fmt.Sprintf(" %s %s %s", spinner, t.name, uiDuration(elapsed)))
+		if t.operation != "" {
+			running = append(running, fmt.Sprintf("   └ %s", t.operation))
+		}
+		for _, line := range t.logLines {
+			running = append(running, fmt.Sprintf("     │ %s", line))
+		}
+	}
+	lines := []string{fmt.Sprintf("[+] Building %s (%d running, %d done)",
+		uiDuration(now.Sub(ui.startTime)), numRunning, len(done))}
+	// Collapse the oldest completed targets when the view does not fit on the screen.
+	maxDone := height - 2 - len(running)
+	if maxDone < 1 {
+		maxDone = 1
+	}
+	if len(done) > maxDone {
+		hidden := len(done) - maxDone + 1
+		done = append([]string{fmt.Sprintf(" ✔ ... %d more targets done", hidden)}, done[hidden:]...)
+	}
+	lines = append(lines, done...)
+	lines = append(lines, running...)
+	if len(lines) > height-1 {
+		lines = lines[:height-1]
+	}
+	var buf bytes.Buffer
+	if ui.linesDrawn > 0 {
+		fmt.Fprintf(&buf, ansiUpLines, ui.linesDrawn)
+	}
+	for _, line := range lines {
+		buf.WriteString(truncateLine(line, width-1))
+		buf.Write(ansiEraseRestLine)
+		buf.WriteByte('\n')
+	}
+	buf.Write(ansiEraseDown)
+	ui.out.Write(buf.Bytes())
+	ui.linesDrawn = len(lines)
+}
+
+// writer returns a writer which prints above the view, while it is live.
+func (ui *progressUI) writer(w io.Writer) io.Writer {
+	return &uiWriter{ui: ui, w: w}
+}
+
+type uiWriter struct {
+	ui  *progressUI
+	w   io.Writer
+	buf []byte
+}
+
+func (uw *uiWriter) Write(p []byte) (int, error) {
+	uw.ui.mu.Lock()
+	defer uw.ui.mu.Unlock()
+	if !uw.ui.running {
+		if len(uw.buf) > 0 {
+			_, err := uw.w.Write(uw.buf)
+			uw.buf = nil
+			if err != nil {
+				return 0, err
+			}
+		}
+		return uw.w.Write(p)
+	}
+	// Only print complete lines, as the view is redrawn after each print.
+	uw.buf = append(uw.buf, p...)
+	lastNewLine := bytes.LastIndexByte(uw.buf, '\n')
+	if lastNewLine == -1 {
+		return len(p), nil
+	}
+	uw.ui.clear()
+	_, err := uw.w.Write(uw.buf[:lastNewLine+1])
+	uw.buf = append([]byte{}, uw.buf[lastNewLine+1:]...)
+	if err != nil {
+		return 0, err
+	}
+	uw.ui.redraw()
+	return len(p), nil
+}
+
+func truncateLine(line string, width int) string {
+	if utf8.RuneCountInString(line) <= width {
+		return line
+	}
+	runes := []rune(line)
+	return string(runes[:width-1]) + "…"
+}
+
+func uiDuration(d time.Duration) string {
+	return fmt.Sprintf("%.1fs", d.Seconds())
+}
@@ -0,0 +1,288 @@
+package builder
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxMarkdownOutputLines is the number of lines of the output of a failed command repeated in
+// the Markdown report, which is meant to fit in a PR comment.
+const maxMarkdownOutputLines = 50
+
+// reportFiles are the paths of the reports to write at the end of the build, if any.
+type reportFiles struct {
+	junit    string
+	markdown string
+}
+
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Name       string           `xml:"name,attr"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Time       string           `xml:"time,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string           `xml:"name,attr"`
+	ClassName  string           `xml:"classname,attr"`
+	Time       string           `xml:"time,attr"`
+	Properties *junitProperties `xml:"properties,omitempty"`
+	Failure    *junitFailure    `xml:"failure,omitempty"`
+	Skipped    *junitSkipped    `xml:"skipped,omitempty"`
+}
+
+// junitProperties wraps the properties of a test case, so that the element is omitted when
+// there are none: omitempty does not apply to the parent of a properties>property slice.
+type junitProperties struct {
+	Properties []junitProperty `xml:"property"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Output  string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// reportTarget is a target (along with its build args) and the operations executed for it.
+type reportTarget struct {
+	key      timingKey
+	vertices []*vertexMonitor
+	failed   int
+	cached   int
+	duration time.Duration
+}
+
+func (rt *reportTarget) name() string {
+	if rt.key.targetBrackets == "" {
+		return rt.key.targetStr
+	}
+	return fmt.Sprintf("%s (%s)", rt.key.targetStr, rt.key.targetBrackets)
+}
+
+// reportTargets groups the operations observed so far by target, in the order in which they
+// have started.
+func (sm *solverMonitor) reportTargets() []*reportTarget {
+	vms := make([]*vertexMonitor, 0, len(sm.vertices))
+	for _, vm := range sm.vertices {
+		if vm.isInternal || vm.operation == "" {
+			continue
+		}
+		if vm.vertex.Started == nil && vm.vertex.Error == "" {
+			// Never executed.
+			continue
+		}
+		vms = append(vms, vm)
+	}
+	sort.Slice(vms, func(i, j int) bool {
+		si, sj := vertexStart(vms[i]), vertexStart(vms[j])
+		if !si.Equal(sj) {
+			return si.Before(sj)
+		}
+		return vms[i].vertex.Digest < vms[j].vertex.Digest
+	})
+	var rts []*reportTarget
+	byKey := make(map[timingKey]*reportTarget)
+	for _, vm := range vms {
+		key := timingKey{
+			targetStr:      vm.targetStr,
+			targetBrackets: vm.targetBrackets,
+			salt:           vm.salt,
+		}
+		rt, ok := byKey[key]
+		if !ok {
+			rt = &reportTarget{key: key}
+			byKey[key] = rt
+			rts = append(rts, rt)
+		}
+		rt.vertices = append(rt.vertices, vm)
+		if vm.isFailed {
+			rt.failed++
+		}
+		if vm.vertex.Cached {
+			rt.cached++
+		}
+		rt.duration += vertexDuration(vm)
+	}
+	return rts
+}
+
+func vertexStart(vm *vertexMonitor) time.Time {
+	if vm.vertex.Started != nil {
+		return *vm.vertex.Started
+	}
+	if vm.vertex.Completed != nil {
+		return *vm.vertex.Completed
+	}
+	return time.Time{}
+}
+
+func vertexDuration(vm *vertexMonitor) time.Duration {
+	if vm.vertex.Started == nil || vm.vertex.Completed == nil {
+		return 0
+	}
+	return vm.vertex.Completed.Sub(*vm.vertex.Started)
+}
+
+// writeReports writes the reports of the build, if requested.
+func (sm *solverMonitor) writeReports() error {
+	if sm.reports.junit == "" && sm.reports.markdown == "" {
+		return nil
+	}
+	rts := sm.reportTargets()
+	total := time.Since(sm.startTime)
+	if sm.reports.junit != "" {
+		dt, err := sm.junitReport(rts, total)
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(sm.reports.junit, dt, 0644)
+		if err != nil {
+			return errors.Wrapf(err, "write junit report %s", sm.reports.junit)
+		}
+	}
+	if sm.reports.markdown != "" {
+		dt := sm.markdownReport(rts, total)
+		err := ioutil.WriteFile(sm.reports.markdown, dt, 0644)
+		if err != nil {
+			return errors.Wrapf(err, "write markdown report %s", sm.reports.markdown)
+		}
+	}
+	return nil
+}
+
+func (sm *solverMonitor) junitReport(rts []*reportTarget, total time.Duration) ([]byte, error) {
+	suites := junitTestSuites{
+		Name: "earthly",
+		Time: junitSeconds(total),
+	}
+	for _, rt := range rts {
+		suite := junitTestSuite{
+			Name:     rt.name(),
+			Tests:    len(rt.vertices),
+			Failures: rt.failed,
+			Time:     junitSeconds(rt.duration),
+		}
+		for _, vm := range rt.vertices {
+			tc := junitTestCase{
+				Name:      vm.operation,
+				ClassName: rt.name(),
+				Time:      junitSeconds(vertexDuration(vm)),
+			}
+			if vm.vertex.Cached {
+				tc.Properties = &junitProperties{
+					Properties: []junitProperty{{Name: "cached", Value: "true"}},
+				}
+			}
+			switch {
+			case vm.isFailed:
+				tc.Failure = &junitFailure{
+					Message: failureReason(vm.vertex.Error),
+					Output:  stripANSI(string(vm.tailOutputBytes())),
+				}
+			case vm.vertex.Error != "":
+				tc.Skipped = &junitSkipped{Message: "canceled"}
+				suite.Skipped++
+			}
+			suite.TestCases = append(suite.TestCases, tc)
+		}
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.TestSuites = append(suites.TestSuites, suite)
+	}
+	dt, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal junit report")
+	}
+	return append([]byte(xml.Header), append(dt, '\n')...), nil
+}
+
+func (sm *solverMonitor) markdownReport(rts []*reportTarget, total time.Duration) []byte {
+	var buf bytes.Buffer
+	operations, cached, failed := 0, 0, 0
+	for _, rt := range rts {
+		operations += len(rt.vertices)
+		cached += rt.cached
+		failed += rt.failed
+	}
+	buf.WriteString("## Earthly build summary\n\n")
+	if failed > 0 {
+		fmt.Fprintf(&buf, "**Failed**: %d of %d operations failed (%d cached) in %s.\n\n",
+			failed, operations, cached, total.Round(time.Millisecond))
+	} else {
+		fmt.Fprintf(&buf, "**Succeeded**: %d operations (%d cached) in %s.\n\n",
+			operations, cached, total.Round(time.Millisecond))
+	}
+	buf.WriteString("| Target | Operations | Cached | Failed | Time |\n")
+	buf.WriteString("| --- | ---: | ---: | ---: | ---: |\n")
+	for _, rt := range rts {
+		fmt.Fprintf(&buf, "| `%s` | %d | %d | %d | %s |\n",
+			rt.name(), len(rt.vertices), rt.cached, rt.failed, rt.duration.Round(time.Millisecond))
+	}
+	if failed == 0 {
+		return buf.Bytes()
+	}
+	buf.WriteString("\n### Failures\n")
+	for _, rt := range rts {
+		for _, vm := range rt.vertices {
+			if !vm.isFailed {
+				continue
+			}
+			fmt.Fprintf(&buf, "\n#### `%s` %s\n\n", rt.name(), failureReason(vm.vertex.Error))
+			fmt.Fprintf(&buf, "`%s`\n", vm.operation)
+			output := strings.TrimRight(stripANSI(string(vm.tailOutputBytes())), "\n")
+			if output == "" {
+				continue
+			}
+			lines := strings.Split(output, "\n")
+			if len(lines) > maxMarkdownOutputLines {
+				lines = append([]string{"[...]"}, lines[len(lines)-maxMarkdownOutputLines:]...)
+			}
+			fmt.Fprintf(&buf, "\n```\n%s\n```\n", strings.Join(lines, "\n"))
+		}
+	}
+	return buf.Bytes()
+}
+
+func (vm *vertexMonitor) tailOutputBytes() []byte {
+	if vm.tailOutput == nil {
+		return nil
+	}
+	return vm.tailOutput.Bytes()
+}
+
+func junitSeconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+var ansiRegexp = regexp.MustCompile("\x1b\\[[0-9;?]*[A-Za-z]")
+
+func stripANSI(s string) string {
+	return ansiRegexp.ReplaceAllString(s, "")
+}
@@ -0,0 +1,137 @@
+package builder
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/moby/buildkit/client"
+	"github.com/opencontainers/go-digest"
+	. "github.com/stretchr/testify/assert"
+)
+
+// testVertex describes a vertex of a test build. The times are in milliseconds since the start
+// of the build.
+type testVertex struct {
+	target    string
+	brackets  string
+	operation string
+	start     int
+	end       int
+	cached    bool
+	err       string
+	output    string
+}
+
+func testSolverMonitor(t *testing.T, tvs []testVertex) *solverMonitor {
+	buildStart := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	sm := &solverMonitor{vertices: make(map[digest.Digest]*vertexMonitor)}
+	for _, tv := range tvs {
+		started := buildStart.Add(time.Duration(tv.start) * time.Millisecond)
+		completed := buildStart.Add(time.Duration(tv.end) * time.Millisecond)
+		vm := &vertexMonitor{
+			vertex: &client.Vertex{
+				Digest:    digest.FromString(tv.operation),
+				Started:   &started,
+				Completed: &completed,
+				Cached:    tv.cached,
+				Error:     tv.err,
+			},
+			targetStr:      tv.target,
+			targetBrackets: tv.brackets,
+			operation:      tv.operation,
+			isFailed:       tv.err != "" && !strings.Contains(tv.err, "context canceled"),
+		}
+		if tv.output != "" {
+			NoError(t, vm.recordOutput([]byte(tv.output)))
+		}
+		sm.vertices[vm.vertex.Digest] = vm
+	}
+	return sm
+}
+
+var testReportBuilds = []struct {
+	name     string
+	vertices []testVertex
+	junit    string
+	markdown string
+}{
+	{
+		"success",
+		[]testVertex{
+			{target: "+deps", operation: "COPY go.mod ./", start: 0, end: 0, cached: true},
+			{target: "+build", brackets: "VERSION=1", operation: "RUN go build", start: 100, end: 2100},
+		},
+		`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="earthly" tests="2" failures="0" time="3.000">
+  <testsuite name="+deps" tests="1" failures="0" skipped="0" time="0.000">
+    <testcase name="COPY go.mod ./" classname="+deps" time="0.000">
+      <properties>
+        <property name="cached" value="true"></property>
+      </properties>
+    </testcase>
+  </testsuite>
+  <testsuite name="+build (VERSION=1)" tests="1" failures="0" skipped="0" time="2.000">
+    <testcase name="RUN go build" classname="+build (VERSION=1)" time="2.000"></testcase>
+  </testsuite>
+</testsuites>
+`,
+		"## Earthly build summary\n\n" +
+			"**Succeeded**: 2 operations (1 cached) in 3s.\n\n" +
+			"| Target | Operations | Cached | Failed | Time |\n" +
+			"| --- | ---: | ---: | ---: | ---: |\n" +
+			"| `+deps` | 1 | 1 | 0 | 0s |\n" +
+			"| `+build (VERSION=1)` | 1 | 0 | 0 | 2s |\n",
+	},
+	{
+		"failure",
+		[]testVertex{
+			{target: "+test", operation: "RUN go test", start: 0, end: 1500, err: "executor failed running [/bin/sh -c go test]: exit code: 2", output: "\x1b[31mFAIL\x1b[0m\nexit status 2\n"},
+			{target: "+lint", operation: "RUN golangci-lint run", start: 200, end: 1600, err: "context canceled"},
+		},
+		`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="earthly" tests="2" failures="1" time="3.000">
+  <testsuite name="+test" tests="1" failures="1" skipped="0" time="1.500">
+    <testcase name="RUN go test" classname="+test" time="1.500">
+      <failure message="exit code 2">FAIL&#xA;exit status 2&#xA;</failure>
+    </testcase>
+  </testsuite>
+  <testsuite name="+lint" tests="1" failures="0" skipped="1" time="1.400">
+    <testcase name="RUN golangci-lint run" classname="+lint" time="1.400">
+      <skipped message="canceled"></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>
+`,
+		"## Earthly build summary\n\n" +
+			"**Failed**: 1 of 2 operations failed (0 cached) in 3s.\n\n" +
+			"| Target | Operations | Cached | Failed | Time |\n" +
+			"| --- | ---: | ---: | ---: | ---: |\n" +
+			"| `+test` | 1 | 0 | 1 | 1.5s |\n" +
+			"| `+lint` | 1 | 0 | 0 | 1.4s |\n" +
+			"\n### Failures\n" +
+			"\n#### `+test` exit code 2\n\n" +
+			"`RUN go test`\n" +
+			"\n```\nFAIL\nexit status 2\n```\n",
+	},
+}
+
+func TestJUnitReport(t *testing.T) {
+	for _, tt := range testReportBuilds {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := testSolverMonitor(t, tt.vertices)
+			dt, err := sm.junitReport(sm.reportTargets(), 3*time.Second)
+			NoError(t, err)
+			Equal(t, tt.junit, string(dt))
+		})
+	}
+}
+
+func TestMarkdownReport(t *testing.T) {
+	for _, tt := range testReportBuilds {
+		t.Run(tt.name, func(t *testing.T) {
+			sm := testSolverMonitor(t, tt.vertices)
+			Equal(t, tt.markdown, string(sm.markdownReport(sm.reportTargets(), 3*time.Second)))
+		})
+	}
+}
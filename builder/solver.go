@@ -22,7 +22,6 @@ import (
 
 type onImageFunc func(context.Context, *errgroup.Group, string) (io.WriteCloser, error)
 type onArtifactFunc func(context.Context, int, domain.Artifact, string, string) (string, error)
-type onFinalArtifactFunc func(context.Context) (string, error)
 
 type solver struct {
 	sm              *solverMonitor
@@ -98,12 +97,12 @@ func (s *solver) solveDockerTar(ctx context.Context, state llb.State, platform s
 	return nil
 }
 
-func (s *solver) buildMainMulti(ctx context.Context, bf gwclient.BuildFunc, onImage onImageFunc, onArtifact onArtifactFunc, onFinalArtifact onFinalArtifactFunc) error {
+func (s *solver) buildMainMulti(ctx context.Context, bf gwclient.BuildFunc, onImage onImageFunc, onArtifact onArtifactFunc) error {
 	ch := make(chan *client.SolveStatus)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	eg, ctx := errgroup.WithContext(ctx)
-	solveOpt, err := s.newSolveOptMulti(ctx, eg, onImage, onArtifact, onFinalArtifact)
+	solveOpt, err := s.newSolveOptMulti(ctx, eg, onImage, onArtifact)
 	if err != nil {
 		return errors.Wrap(err, "new solve opt")
 	}
@@ -125,6 +124,31 @@ func (s *solver) buildMainMulti(ctx context.Context, bf gwclient.BuildFunc, onIm
 	return nil
 }
 
+// convertOnly runs the build function without exporting its result, which is expected to
+// be empty.
+func (s *solver) convertOnly(ctx context.Context, bf gwclient.BuildFunc) error {
+	solveOpt, err := s.newSolveOptMain()
+	if err != nil {
+		return errors.Wrap(err, "new solve opt")
+	}
+	ch := make(chan *client.SolveStatus)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.Go(func() error {
+		var err error
+		_, err = s.bkClient.Build(ctx, *solveOpt, "", bf, ch)
+		if err != nil {
+			return errors.Wrap(err, "bkClient.Build")
+		}
+		return nil
+	})
+	eg.Go(func() error {
+		return s.sm.monitorProgress(ctx, ch)
+	})
+	return eg.Wait()
+}
+
 func (s *solver) solveMain(ctx context.Context, state llb.State, platform specs.Platform) error {
 	dt, err := state.Marshal(ctx, llb.Platform(platform))
 	if err != nil {
@@ -184,7 +208,7 @@ func (s *solver) newSolveOptDocker(img *image.Image, dockerTag string, w io.Writ
 	}, nil
 }
 
-func (s *solver) newSolveOptMulti(ctx context.Context, eg *errgroup.Group, onImage onImageFunc, onArtifact onArtifactFunc, onFinalArtifact onFinalArtifactFunc) (*client.SolveOpt, error) {
+func (s *solver) newSolveOptMulti(ctx context.Context, eg *errgroup.Group, onImage onImageFunc, onArtifact onArtifactFunc) (*client.SolveOpt, error) {
 	var cacheImports []client.CacheOptionsEntry
 	for ci := range s.cacheImports {
 		cacheImports = append(cacheImports, newCacheImportOpt(ci))
@@ -216,9 +240,6 @@ func (s *solver) newSolveOptMulti(ctx context.Context, eg *errgroup.Group, onIma
 						// Use the other fun for images.
 						return "", nil
 					}
-					if md["final-artifact"] == "true" {
-						return onFinalArtifact(ctx)
-					}
 					indexStr := md["dir-index"]
 					index, err := strconv.Atoi(indexStr)
 					if err != nil {
@@ -40,7 +40,11 @@ type vertexMonitor struct {
 	headerPrinted  bool
 	isInternal     bool
 	isError        bool
-	tailOutput     *circbuf.Buffer
+	isFailed       bool
+	// The vertex events already written to the events file.
+	startedLogged   bool
+	completedLogged bool
+	tailOutput      *circbuf.Buffer
 	// Line of output that has not yet been terminated with a \n.
 	openLine            []byte
 	lastOpenLineUpdate  time.Time
@@ -81,17 +85,9 @@ var ansiSupported = os.Getenv("TERM") != "dumb" &&
 	(isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()))
 
 func (vm *vertexMonitor) printOutput(output []byte, sameAsLast bool) error {
-	if vm.tailOutput == nil {
-		var err error
-		vm.tailOutput, err = circbuf.NewBuffer(tailErrorBufferSizeBytes)
-		if err != nil {
-			return errors.Wrap(err, "allocate buffer for output")
-		}
-	}
-	// Use the raw output for the tail buffer.
-	_, err := vm.tailOutput.Write(output)
+	err := vm.recordOutput(output)
 	if err != nil {
-		return errors.Wrap(err, "write to in-memory output buffer")
+		return err
 	}
 	printOutput := make([]byte, 0, len(vm.openLine)+len(output)+10)
 	if bytes.HasPrefix(output, []byte{'\n'}) && len(vm.openLine) > 0 && !vm.lastOpenLineSkipped {
@@ -137,6 +133,23 @@ func (vm *vertexMonitor) printOutput(output []byte, sameAsLast bool) error {
 	return nil
 }
 
+// recordOutput keeps the tail of the output, for repeating it in case of failure.
+func (vm *vertexMonitor) recordOutput(output []byte) error {
+	if vm.tailOutput == nil {
+		var err error
+		vm.tailOutput, err = circbuf.NewBuffer(tailErrorBufferSizeBytes)
+		if err != nil {
+			return errors.Wrap(err, "allocate buffer for output")
+		}
+	}
+	// Use the raw output for the tail buffer.
+	_, err := vm.tailOutput.Write(output)
+	if err != nil {
+		return errors.Wrap(err, "write to in-memory output buffer")
+	}
+	return nil
+}
+
 func (vm *vertexMonitor) shouldPrintProgress(id string, percent int, verbose bool, sameAsLast bool) bool {
 	if !vm.headerPrinted {
 		return false
@@ -204,6 +217,11 @@ func (vm *vertexMonitor) printTimingInfo() {
 type solverMonitor struct {
 	console                      conslogging.ConsoleLogger
 	verbose                      bool
+	keepGoing                    bool
+	events                       *eventLogger
+	reports                      reportFiles
+	ui                           *progressUI
+	logDir                       *logDir
 	vertices                     map[digest.Digest]*vertexMonitor
 	saltSeen                     map[string]bool
 	lastVertexOutput             *vertexMonitor
@@ -214,6 +232,8 @@ type solverMonitor struct {
 	mu      sync.Mutex
 	success bool
 	ongoing bool
+	// The vertices which failed in any of the solves of the build.
+	failedVertices []*vertexMonitor
 }
 
 type timingKey struct {
@@ -222,10 +242,15 @@ type timingKey struct {
 	salt           string
 }
 
-func newSolverMonitor(console conslogging.ConsoleLogger, verbose bool) *solverMonitor {
+func newSolverMonitor(console conslogging.ConsoleLogger, verbose bool, keepGoing bool, events *eventLogger, reports reportFiles, ui *progressUI, logDir *logDir) *solverMonitor {
 	return &solverMonitor{
 		console:     console,
 		verbose:     verbose,
+		keepGoing:   keepGoing,
+		events:      events,
+		reports:     reports,
+		ui:          ui,
+		logDir:      logDir,
 		vertices:    make(map[digest.Digest]*vertexMonitor),
 		saltSeen:    make(map[string]bool),
 		timingTable: make(map[timingKey]time.Duration),
@@ -237,7 +262,12 @@ func (sm *solverMonitor) monitorProgress(ctx context.Context, ch chan *client.So
 	sm.mu.Lock()
 	sm.ongoing = true
 	sm.mu.Unlock()
+	if sm.ui != nil {
+		sm.ui.start()
+		defer sm.ui.stop()
+	}
 	var errVertex *vertexMonitor
+	var failedVertices []*vertexMonitor
 Loop:
 	for {
 		select {
@@ -263,7 +293,10 @@ Loop:
 					sm.vertices[vertex.Digest] = vm
 				}
 				vm.vertex = vertex
-				if !vm.headerPrinted &&
+				if sm.events != nil {
+					sm.logVertexEvents(vm)
+				}
+				if sm.ui == nil && !vm.headerPrinted &&
 					((!vm.isInternal && (vertex.Cached || vertex.Started != nil)) || vertex.Error != "") {
 					sm.printHeader(vm)
 				}
@@ -277,8 +310,21 @@ Loop:
 						if errVertex == nil && vm.isError {
 							errVertex = vm
 						}
+						if !vm.isFailed {
+							vm.isFailed = true
+							failedVertices = append(failedVertices, vm)
+							sm.mu.Lock()
+							sm.failedVertices = append(sm.failedVertices, vm)
+							sm.mu.Unlock()
+						}
 					}
 				}
+				if sm.ui != nil && !vm.isInternal {
+					sm.ui.updateVertex(vm)
+				}
+				if sm.logDir != nil && !vm.isInternal {
+					sm.logDir.vertex(vm)
+				}
 				if sm.verbose {
 					vm.printTimingInfo()
 					sm.recordTiming(vm.targetStr, vm.targetBrackets, vm.salt, vertex)
@@ -297,7 +343,17 @@ Loop:
 				if vs.Completed != nil {
 					progress = 100
 				}
-				sm.printProgress(vm, vs.ID, progress)
+				if sm.events != nil {
+					ev := vm.newEvent(eventProgress)
+					ev.Time = vs.Timestamp
+					ev.ID = vs.ID
+					ev.Current = vs.Current
+					ev.Total = vs.Total
+					sm.events.log(ev)
+				}
+				if sm.ui == nil {
+					sm.printProgress(vm, vs.ID, progress)
+				}
 			}
 			for _, logLine := range ss.Logs {
 				vm, ok := sm.vertices[logLine.Vertex]
@@ -305,6 +361,24 @@ Loop:
 					// No logging for internal operations.
 					continue
 				}
+				if sm.events != nil {
+					ev := vm.newEvent(eventLog)
+					ev.Time = logLine.Timestamp
+					ev.Stream = logLine.Stream
+					ev.Data = string(logLine.Data)
+					sm.events.log(ev)
+				}
+				if sm.logDir != nil {
+					sm.logDir.log(vm, logLine.Timestamp, logLine.Data)
+				}
+				if sm.ui != nil {
+					err := vm.recordOutput(logLine.Data)
+					if err != nil {
+						return err
+					}
+					sm.ui.log(vm, logLine.Data)
+					continue
+				}
 				if !vm.headerPrinted {
 					sm.printHeader(vm)
 				}
@@ -315,9 +389,17 @@ Loop:
 			}
 		}
 	}
-	if errVertex != nil {
+	if sm.keepGoing {
+		if len(failedVertices) > 0 {
+			sm.reprintFailures(failedVertices)
+		}
+	} else if errVertex != nil {
 		sm.reprintFailure(errVertex)
 	}
+	if sm.logDir != nil {
+		sm.printLogPaths(failedVertices)
+	}
+	sm.annotateFailures(failedVertices)
 	sm.mu.Lock()
 	if sm.success {
 		sm.console.PrintSuccess()
@@ -353,6 +435,104 @@ func (sm *solverMonitor) printHeader(vm *vertexMonitor) {
 	vm.printHeader(!seen || sm.verbose)
 }
 
+// printLogPaths prints the paths of the log files of the failed targets.
+func (sm *solverMonitor) printLogPaths(failedVertices []*vertexMonitor) {
+	printed := make(map[string]bool)
+	for _, vm := range failedVertices {
+		path, ok := sm.logDir.pathOf(vm)
+		if !ok || printed[path] {
+			continue
+		}
+		printed[path] = true
+		vm.console.WithFailed(true).Printf("See the full log of the failed target in %s\n", path)
+	}
+}
+
+func (vm *vertexMonitor) newEvent(eventType string) buildEvent {
+	return buildEvent{
+		Type:   eventType,
+		Target: vm.targetStr,
+		Salt:   vm.salt,
+		Digest: vm.vertex.Digest.String(),
+	}
+}
+
+// logVertexEvents writes the events of the vertex which have not been written yet.
+func (sm *solverMonitor) logVertexEvents(vm *vertexMonitor) {
+	vertex := vm.vertex
+	if vertex.Cached && !vm.completedLogged {
+		vm.startedLogged = true
+		vm.completedLogged = true
+		ev := vm.newEvent(eventVertexCached)
+		ev.Operation = vm.operation
+		sm.events.log(ev)
+		return
+	}
+	if vertex.Started != nil && !vm.startedLogged {
+		vm.startedLogged = true
+		ev := vm.newEvent(eventVertexStarted)
+		ev.Time = *vertex.Started
+		ev.Operation = vm.operation
+		sm.events.log(ev)
+	}
+	if (vertex.Completed != nil || vertex.Error != "") && !vm.completedLogged {
+		vm.completedLogged = true
+		ev := vm.newEvent(eventVertexCompleted)
+		if vertex.Error != "" {
+			ev.Type = eventVertexError
+			if strings.Contains(vertex.Error, "context canceled") {
+				ev.Type = eventVertexCanceled
+			}
+			ev.Error = vertex.Error
+		}
+		if vertex.Completed != nil {
+			ev.Time = *vertex.Completed
+			if vertex.Started != nil {
+				ev.Duration = vertex.Completed.Sub(*vertex.Started).Seconds()
+			}
+		}
+		ev.Operation = vm.operation
+		sm.events.log(ev)
+	}
+}
+
+// Finish emits the summary of the whole build, across all of its solves. It is called once,
+// at the end of the build.
+func (sm *solverMonitor) Finish() {
+	if sm.events != nil {
+		sm.logSummary()
+	}
+	err := sm.writeReports()
+	if err != nil {
+		sm.console.Warnf("Error: %v\n", err)
+	}
+}
+
+func (sm *solverMonitor) logSummary() {
+	summary := &eventSummary{
+		Vertices: len(sm.vertices),
+		Failures: []eventFailure{},
+		Duration: time.Since(sm.startTime).Seconds(),
+	}
+	for _, vm := range sm.vertices {
+		if vm.vertex.Cached {
+			summary.Cached++
+		}
+	}
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	for _, vm := range sm.failedVertices {
+		summary.Failures = append(summary.Failures, eventFailure{
+			Target:    vm.targetStr,
+			Salt:      vm.salt,
+			Digest:    vm.vertex.Digest.String(),
+			Operation: vm.operation,
+			Error:     vm.vertex.Error,
+		})
+	}
+	sm.events.log(buildEvent{Type: eventBuildSummary, Summary: summary})
+}
+
 func (sm *solverMonitor) recordTiming(targetStr, targetBrackets, salt string, vertex *client.Vertex) {
 	if vertex.Started == nil || vertex.Completed == nil {
 		return
@@ -422,22 +602,56 @@ func (sm *solverMonitor) PrintTiming() {
 func (sm *solverMonitor) reprintFailure(errVertex *vertexMonitor) {
 	sm.console.Warnf("Repeating the output of the command that caused the failure\n")
 	sm.console.PrintFailure()
-	errVertex.console = errVertex.console.WithFailed(true)
-	errVertex.printHeader(true)
-	if errVertex.tailOutput != nil {
-		isTruncated := (errVertex.tailOutput.TotalWritten() > errVertex.tailOutput.Size())
-		if errVertex.tailOutput.TotalWritten() == 0 {
-			errVertex.console.Printf("[no output]\n")
+	errVertex.reprintOutput()
+}
+
+// reprintFailures repeats the output of all the commands that failed, followed by a summary
+// of all the failures.
+func (sm *solverMonitor) reprintFailures(failedVertices []*vertexMonitor) {
+	sm.console.Warnf("Repeating the output of the commands that caused failures\n")
+	sm.console.PrintFailure()
+	for _, vm := range failedVertices {
+		if vm.isError {
+			vm.reprintOutput()
+		}
+	}
+	sm.console.
+		WithMetadataMode(true).
+		Printf("Summary of failures\n")
+	for _, vm := range failedVertices {
+		vm.console.WithFailed(true).Printf("%s: %s\n", vm.operation, failureReason(vm.vertex.Error))
+	}
+}
+
+func (vm *vertexMonitor) reprintOutput() {
+	vm.console = vm.console.WithFailed(true)
+	vm.printHeader(true)
+	if vm.tailOutput != nil {
+		isTruncated := (vm.tailOutput.TotalWritten() > vm.tailOutput.Size())
+		if vm.tailOutput.TotalWritten() == 0 {
+			vm.console.Printf("[no output]\n")
 		} else {
 			if isTruncated {
-				errVertex.console.Printf("[...]\n")
+				vm.console.Printf("[...]\n")
 			}
-			errVertex.console.PrintBytes(errVertex.tailOutput.Bytes())
+			vm.console.PrintBytes(vm.tailOutput.Bytes())
 		}
 	} else {
-		errVertex.console.Printf("[no output]\n")
+		vm.console.Printf("[no output]\n")
 	}
-	errVertex.printError()
+	vm.printError()
+}
+
+var exitCodeRegexp = regexp.MustCompile("exit code: ([0-9]+)")
+
+// failureReason returns the exit code of a failed command, or the whole error of any other
+// failed operation.
+func failureReason(vertexError string) string {
+	match := exitCodeRegexp.FindStringSubmatch(vertexError)
+	if match == nil {
+		return vertexError
+	}
+	return fmt.Sprintf("exit code %s", match[1])
 }
 
 var vertexRegexp = regexp.MustCompile("^\\[([^\\]]*)\\] (.*)$")
@@ -0,0 +1,25 @@
+package builder
+
+import (
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestFailureReason(t *testing.T) {
+	var tests = []struct {
+		vertexError string
+		expected    string
+	}{
+		{"executor failed running [/bin/sh -c false]: exit code: 1", "exit code 1"},
+		{"executor failed running [/bin/sh -c exit 127]: exit code: 127", "exit code 127"},
+		{"failed to compute cache key: \"/out\" not found: not found", "failed to compute cache key: \"/out\" not found: not found"},
+		{"context canceled", "context canceled"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.vertexError, func(t *testing.T) {
+			Equal(t, tt.expected, failureReason(tt.vertexError))
+		})
+	}
+}
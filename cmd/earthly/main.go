@@ -42,6 +42,7 @@ import (
 	"github.com/earthly/earthly/earthfile2llb"
 	"github.com/earthly/earthly/fileutil"
 	"github.com/earthly/earthly/llbutil"
+	"github.com/earthly/earthly/lsp"
 	"github.com/earthly/earthly/secretsclient"
 	"github.com/earthly/earthly/termutil"
 	"github.com/earthly/earthly/variables"
@@ -89,6 +90,16 @@ type cliFlags struct {
 	push                   bool
 	ci                     bool
 	noOutput               bool
+	dryRun                 bool
+	keepGoing              bool
+	eventsFile             string
+	reportJUnit            string
+	reportMarkdown         string
+	interactive            bool
+	logDir                 string
+	exportLLB              string
+	exportLLBFormat        string
+	exportLLBAll           bool
 	noCache                bool
 	pruneAll               bool
 	pruneReset             bool
@@ -125,6 +136,10 @@ type cliFlags struct {
 	termsConditionsPrivacy bool
 	authToken              string
 	noFakeDep              bool
+	fmtCheck               bool
+	lintJSON               bool
+	graphFormat            string
+	lsJSON                 bool
 }
 
 var (
@@ -339,6 +354,64 @@ func newEarthlyApp(ctx context.Context, console conslogging.ConsoleLogger) *eart
 			Usage:       wrap("Do not output artifacts or images", "(using --push is still allowed)"),
 			Destination: &app.noOutput,
 		},
+		&cli.BoolFlag{
+			Name:        "dry-run",
+			EnvVars:     []string{"EARTHLY_DRY_RUN"},
+			Usage:       "Print the targets, images and artifacts which would be built, without building them",
+			Destination: &app.dryRun,
+		},
+		&cli.BoolFlag{
+			Name:        "keep-going",
+			EnvVars:     []string{"EARTHLY_KEEP_GOING"},
+			Usage:       wrap("Keep building the targets which do not depend on a failed one", "and summarize all the failures at the end"),
+			Destination: &app.keepGoing,
+		},
+		&cli.StringFlag{
+			Name:        "events-file",
+			EnvVars:     []string{"EARTHLY_EVENTS_FILE"},
+			Usage:       "Write the build events to the given file, as JSON lines",
+			Destination: &app.eventsFile,
+		},
+		&cli.StringFlag{
+			Name:        "report-junit",
+			EnvVars:     []string{"EARTHLY_REPORT_JUNIT"},
+			Usage:       "Write a JUnit XML report of the build to the given file",
+			Destination: &app.reportJUnit,
+		},
+		&cli.StringFlag{
+			Name:        "report-markdown",
+			EnvVars:     []string{"EARTHLY_REPORT_MARKDOWN"},
+			Usage:       "Write a Markdown summary of the build, suitable for a PR comment, to the given file",
+			Destination: &app.reportMarkdown,
+		},
+		&cli.BoolFlag{
+			Name:        "interactive",
+			EnvVars:     []string{"EARTHLY_INTERACTIVE"},
+			Usage:       wrap("Show a live view of the running targets, instead of their interleaved output", "(only in a terminal, and not with --ci)"),
+			Destination: &app.interactive,
+		},
+		&cli.StringFlag{
+			Name:        "log-dir",
+			EnvVars:     []string{"EARTHLY_LOG_DIR"},
+			Usage:       "Write the output of each target to its own file within the given dir, along with a combined log",
+			Destination: &app.logDir,
+		},
+		&cli.StringFlag{
+			Name:        "export-llb",
+			Usage:       "Write the LLB definition of the target to the given file, without building it",
+			Destination: &app.exportLLB,
+		},
+		&cli.StringFlag{
+			Name:        "export-llb-format",
+			Value:       builder.LLBFormatJSON,
+			Usage:       "The format of the LLB written by --export-llb: pb or json",
+			Destination: &app.exportLLBFormat,
+		},
+		&cli.BoolFlag{
+			Name:        "export-llb-all",
+			Usage:       "Write the LLB definition of every target built, rather than only the referenced one (json format only)",
+			Destination: &app.exportLLBAll,
+		},
 		&cli.BoolFlag{
 			Name:        "no-cache",
 			EnvVars:     []string{"EARTHLY_NO_CACHE"},
@@ -521,6 +594,77 @@ func newEarthlyApp(ctx context.Context, console conslogging.ConsoleLogger) *eart
 				},
 			},
 		},
+		{
+			Name:      "fmt",
+			Usage:     "Format Earthfiles",
+			UsageText: "earthly [options] fmt [--check] [<path>...]",
+			Description: "Rewrites the Earthfiles at the given paths (or in the current directory) in " +
+				"canonical form; with --check, the files are left untouched and the command fails " +
+				"if any of them is not formatted",
+			Action: app.actionFmt,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:        "check",
+					Usage:       "Fail if an Earthfile is not formatted, instead of formatting it",
+					Destination: &app.fmtCheck,
+				},
+			},
+		},
+		{
+			Name:      "lint",
+			Usage:     "Check Earthfiles for common mistakes",
+			UsageText: "earthly [options] lint [--json] [<path>...]",
+			Description: "Reports the rule violations found in the Earthfiles at the given paths (or in " +
+				"the current directory); rules may be disabled via # lint:disable comments",
+			Action: app.actionLint,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:        "json",
+					Usage:       "Print the rule violations as JSON",
+					Destination: &app.lintJSON,
+				},
+			},
+		},
+		{
+			Name:      "graph",
+			Usage:     "Print the dependency graph of targets",
+			UsageText: "earthly [options] graph [--format dot|json|mermaid] [<target-ref>...]",
+			Description: "Prints the graph of the FROM, COPY, BUILD, DO and WITH DOCKER --load references " +
+				"between the given targets (or all the targets of the Earthfile in the current " +
+				"directory) and the local targets they depend on; the Earthfiles are parsed, not built, " +
+				"so refs containing variables are shown unresolved and the refs within all the branches " +
+				"of IF and FOR blocks are included",
+			Action: app.actionGraph,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:        "format",
+					Usage:       "The output format: dot, json or mermaid",
+					Value:       "dot",
+					Destination: &app.graphFormat,
+				},
+			},
+		},
+		{
+			Name:      "ls",
+			Usage:     "List the targets of an Earthfile",
+			UsageText: "earthly [options] ls [--json] [<path>]",
+			Description: "Lists the targets of the Earthfile at the given path (or in the current " +
+				"directory), along with their descriptions and the args they declare",
+			Action: app.actionLs,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:        "json",
+					Usage:       "Print the targets as JSON",
+					Destination: &app.lsJSON,
+				},
+			},
+		},
+		{
+			Name:        "lsp",
+			Usage:       "Run the Earthfile language server",
+			Description: "Runs a Language Server Protocol server for Earthfiles, communicating over stdin and stdout",
+			Action:      app.actionLSP,
+		},
 		{
 			Name:  "org",
 			Usage: "Earthly organization administration *experimental*",
@@ -1949,6 +2093,228 @@ func (app *earthlyApp) actionDocker2Earthly(c *cli.Context) error {
 	return docker2earthly.Docker2Earthly(app.dockerfilePath, app.earthfilePath, app.earthfileFinalImage)
 }
 
+func (app *earthlyApp) actionFmt(c *cli.Context) error {
+	app.commandName = "fmt"
+	paths, err := earthfilePaths(c.Args().Slice())
+	if err != nil {
+		return err
+	}
+	unformatted := 0
+	for _, p := range paths {
+		fi, err := os.Stat(p)
+		if err != nil {
+			return errors.Wrapf(err, "stat %s", p)
+		}
+		current, err := ioutil.ReadFile(p)
+		if err != nil {
+			return errors.Wrapf(err, "read %s", p)
+		}
+		formatted, err := earthfile2llb.Format(p)
+		if err != nil {
+			return errors.Wrapf(err, "format %s", p)
+		}
+		if bytes.Equal(current, formatted) {
+			continue
+		}
+		if app.fmtCheck {
+			app.console.Warnf("%s is not formatted\n", p)
+			unformatted++
+			continue
+		}
+		err = ioutil.WriteFile(p, formatted, fi.Mode())
+		if err != nil {
+			return errors.Wrapf(err, "write %s", p)
+		}
+		app.console.Printf("formatted %s\n", p)
+	}
+	if unformatted > 0 {
+		return fmt.Errorf("%d Earthfile(s) not formatted; run earthly fmt to fix", unformatted)
+	}
+	return nil
+}
+
+func (app *earthlyApp) actionLint(c *cli.Context) error {
+	app.commandName = "lint"
+	paths, err := earthfilePaths(c.Args().Slice())
+	if err != nil {
+		return err
+	}
+	issues := []earthfile2llb.LintIssue{}
+	for _, p := range paths {
+		fileIssues, err := earthfile2llb.Lint(p)
+		if err != nil {
+			return errors.Wrapf(err, "lint %s", p)
+		}
+		issues = append(issues, fileIssues...)
+	}
+	if app.lintJSON {
+		out, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshal lint issues")
+		}
+		fmt.Println(string(out))
+	} else {
+		for _, li := range issues {
+			fmt.Println(li.String())
+		}
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%d lint issue(s) found", len(issues))
+	}
+	return nil
+}
+
+func (app *earthlyApp) actionGraph(c *cli.Context) error {
+	app.commandName = "graph"
+	var targets []domain.Target
+	for _, arg := range c.Args().Slice() {
+		target, err := domain.ParseTarget(arg)
+		if err != nil {
+			return errors.Wrapf(err, "parse target name %s", arg)
+		}
+		targets = append(targets, target)
+	}
+	if len(targets) == 0 {
+		var err error
+		targets, err = earthfile2llb.AllTargets(".")
+		if err != nil {
+			return err
+		}
+	}
+	graph, err := earthfile2llb.BuildGraph(targets)
+	if err != nil {
+		return err
+	}
+	switch app.graphFormat {
+	case "dot":
+		fmt.Print(graph.DOT())
+	case "mermaid":
+		fmt.Print(graph.Mermaid())
+	case "json":
+		out, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshal graph")
+		}
+		fmt.Println(string(out))
+	default:
+		return fmt.Errorf("invalid graph format %s, expected dot, json or mermaid", app.graphFormat)
+	}
+	return nil
+}
+
+// lsArg and lsTarget are the JSON output of earthly ls.
+type lsArg struct {
+	Name    string  `json:"name"`
+	Default *string `json:"default,omitempty"`
+}
+
+type lsTarget struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Args        []lsArg `json:"args"`
+}
+
+func (app *earthlyApp) actionLs(c *cli.Context) error {
+	app.commandName = "ls"
+	if c.NArg() > 1 {
+		return errors.New("invalid number of arguments provided")
+	}
+	paths, err := earthfilePaths(c.Args().Slice())
+	if err != nil {
+		return err
+	}
+	ol, err := earthfile2llb.ReadOutline(paths[0])
+	if err != nil {
+		return err
+	}
+	args := func(target string) []lsArg {
+		ret := []lsArg{}
+		for _, arg := range ol.Args {
+			if arg.Target != target {
+				continue
+			}
+			la := lsArg{Name: arg.Name}
+			if arg.HasDefault {
+				def := arg.Default
+				la.Default = &def
+			}
+			ret = append(ret, la)
+		}
+		return ret
+	}
+	globalArgs := args("")
+	targets := []lsTarget{}
+	for _, t := range ol.Targets {
+		targets = append(targets, lsTarget{Name: t.Name, Description: t.Description, Args: args(t.Name)})
+	}
+
+	if app.lsJSON {
+		out, err := json.MarshalIndent(struct {
+			Args    []lsArg    `json:"args"`
+			Targets []lsTarget `json:"targets"`
+		}{globalArgs, targets}, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshal targets")
+		}
+		fmt.Println(string(out))
+		return nil
+	}
+	argString := func(la lsArg) string {
+		if la.Default == nil {
+			return "--" + la.Name
+		}
+		return fmt.Sprintf("--%s=%s", la.Name, *la.Default)
+	}
+	if len(globalArgs) > 0 {
+		fmt.Println("Global args:")
+		for _, la := range globalArgs {
+			fmt.Printf("    %s\n", argString(la))
+		}
+		fmt.Println()
+	}
+	for _, t := range targets {
+		fmt.Printf("+%s\n", t.Name)
+		if t.Description != "" {
+			for _, line := range strings.Split(t.Description, "\n") {
+				fmt.Printf("    %s\n", line)
+			}
+		}
+		for _, la := range t.Args {
+			fmt.Printf("    %s\n", argString(la))
+		}
+	}
+	return nil
+}
+
+func (app *earthlyApp) actionLSP(c *cli.Context) error {
+	app.commandName = "lsp"
+	return lsp.NewServer(os.Stdin, os.Stdout).Serve(c.Context)
+}
+
+// earthfilePaths resolves the paths given to the fmt and lint commands to Earthfiles. A
+// directory resolves to the Earthfile (or build.earth) within it, and no path at all to the
+// one in the current directory.
+func earthfilePaths(paths []string) ([]string, error) {
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	var ret []string
+	for _, p := range paths {
+		if fileutil.DirExists(p) {
+			dir := p
+			p = filepath.Join(dir, "Earthfile")
+			if !fileutil.FileExists(p) {
+				p = filepath.Join(dir, "build.earth")
+			}
+			if !fileutil.FileExists(p) {
+				return nil, fmt.Errorf("no Earthfile nor build.earth file found in %s", dir)
+			}
+		}
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
+
 func (app *earthlyApp) actionBuild(c *cli.Context) error {
 	app.commandName = "build"
 
@@ -1958,10 +2324,19 @@ func (app *earthlyApp) actionBuild(c *cli.Context) error {
 		if app.remoteCache == "" && app.push {
 			app.saveInlineCache = true
 		}
+		ciName, _ := analytics.DetectCI()
+		app.console = app.console.WithGroupStyle(conslogging.GroupStyleForCI(ciName))
 	}
 	if app.imageMode && app.artifactMode {
 		return errors.New("both image and artifact modes cannot be active at the same time")
 	}
+	if app.exportLLBFormat != builder.LLBFormatJSON && app.exportLLBFormat != builder.LLBFormatPB {
+		return fmt.Errorf("invalid --export-llb-format %s, expected %s or %s",
+			app.exportLLBFormat, builder.LLBFormatJSON, builder.LLBFormatPB)
+	}
+	if app.exportLLBAll && app.exportLLBFormat != builder.LLBFormatJSON {
+		return errors.New("--export-llb-all is only supported with --export-llb-format json")
+	}
 	if (app.imageMode && app.noOutput) || (app.artifactMode && app.noOutput) {
 		if app.ci {
 			app.noOutput = false
@@ -1969,57 +2344,48 @@ func (app *earthlyApp) actionBuild(c *cli.Context) error {
 			return errors.New("cannot use --no-output with image or artifact modes")
 		}
 	}
-	var target domain.Target
-	var artifact domain.Artifact
-	destPath := "./"
+	var targets []domain.Target
+	var artifacts []builder.ArtifactOutput
 	if app.imageMode {
 		if c.NArg() == 0 {
 			cli.ShowAppHelp(c)
 			return fmt.Errorf(
 				"no image reference provided. Try %s --image +<target-name>", c.App.Name)
-		} else if c.NArg() != 1 {
-			cli.ShowAppHelp(c)
-			return errors.New("invalid number of args")
 		}
-		targetName := c.Args().Get(0)
 		var err error
-		target, err = domain.ParseTarget(targetName)
+		targets, err = parseTargets(c.Args().Slice())
 		if err != nil {
-			return errors.Wrapf(err, "parse target name %s", targetName)
+			return err
 		}
 	} else if app.artifactMode {
 		if c.NArg() == 0 {
 			cli.ShowAppHelp(c)
 			return fmt.Errorf(
 				"no artifact reference provided. Try %s --artifact +<target-name>/<artifact-name>", c.App.Name)
-		} else if c.NArg() != 1 && c.NArg() != 2 {
-			cli.ShowAppHelp(c)
-			return errors.New("invalid number of args")
-		}
-		artifactName := c.Args().Get(0)
-		if c.NArg() == 2 {
-			destPath = c.Args().Get(1)
 		}
 		var err error
-		artifact, err = domain.ParseArtifact(artifactName)
+		artifacts, err = parseArtifactOutputs(c.Args().Slice())
 		if err != nil {
-			return errors.Wrapf(err, "parse artifact name %s", artifactName)
+			return err
+		}
+		for _, ao := range artifacts {
+			targets = append(targets, ao.Artifact.Target)
 		}
-		target = artifact.Target
 	} else {
 		if c.NArg() == 0 {
 			cli.ShowAppHelp(c)
 			return fmt.Errorf(
 				"no target reference provided. Try %s +<target-name>", c.App.Name)
-		} else if c.NArg() != 1 {
-			cli.ShowAppHelp(c)
-			return errors.New("invalid number of args")
 		}
-		targetName := c.Args().Get(0)
 		var err error
-		target, err = domain.ParseTarget(targetName)
+		targets, err = parseTargets(c.Args().Slice())
 		if err != nil {
-			return errors.Wrapf(err, "parse target name %s", targetName)
+			return err
+		}
+	}
+	for _, target := range targets {
+		if target.IsImportReference() {
+			return errors.Errorf("import reference %s can only be used within an Earthfile", target.String())
 		}
 	}
 	bkClient, bkIP, err := app.newBuildkitdClient(c.Context)
@@ -2153,6 +2519,12 @@ func (app *earthlyApp) actionBuild(c *cli.Context) error {
 		BuildContextProvider: buildContextProvider,
 		GitLookup:            gitLookup,
 		UseFakeDep:           !app.noFakeDep,
+		KeepGoing:            app.keepGoing,
+		EventsFile:           app.eventsFile,
+		JUnitReport:          app.reportJUnit,
+		MarkdownReport:       app.reportMarkdown,
+		Interactive:          app.interactive && !app.ci && termutil.IsTTY(),
+		LogDir:               app.logDir,
 	}
 	b, err := builder.NewBuilder(c.Context, builderOpts)
 	if err != nil {
@@ -2160,7 +2532,7 @@ func (app *earthlyApp) actionBuild(c *cli.Context) error {
 	}
 
 	if len(platformsSlice) != 1 {
-		return errors.Errorf("multi-platform builds are not yet supported on the command line. You may, however, create a target with the instruction BUILD --plaform ... --platform ... %s", target)
+		return errors.Errorf("multi-platform builds are not yet supported on the command line. You may, however, create a target with the instruction BUILD --plaform ... --platform ... %s", targets[0])
 	}
 	buildOpts := builder.BuildOpt{
 		PrintSuccess:          true,
@@ -2168,18 +2540,56 @@ func (app *earthlyApp) actionBuild(c *cli.Context) error {
 		NoOutput:              app.noOutput,
 		OnlyFinalTargetImages: app.imageMode,
 		Platform:              platformsSlice[0],
+		DryRun:                app.dryRun,
+		ExportLLB:             app.exportLLB,
+		ExportLLBFormat:       app.exportLLBFormat,
+		ExportLLBAll:          app.exportLLBAll,
 	}
 	if app.artifactMode {
-		buildOpts.OnlyArtifact = &artifact
-		buildOpts.OnlyArtifactDestPath = destPath
+		buildOpts.OnlyArtifacts = artifacts
 	}
-	_, err = b.BuildTarget(c.Context, target, buildOpts)
+	_, err = b.BuildTargets(c.Context, targets, buildOpts)
 	if err != nil {
 		return errors.Wrap(err, "build target")
 	}
 	return nil
 }
 
+// parseTargets parses the target references given on the command line.
+func parseTargets(args []string) ([]domain.Target, error) {
+	var targets []domain.Target
+	for _, targetName := range args {
+		target, err := domain.ParseTarget(targetName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse target name %s", targetName)
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// parseArtifactOutputs parses the artifact references given on the command line, each
+// optionally followed by its destination path. An argument which is not a valid artifact
+// reference is the destination path of the artifact preceding it.
+func parseArtifactOutputs(args []string) ([]builder.ArtifactOutput, error) {
+	var artifacts []builder.ArtifactOutput
+	hasDestPath := true
+	for _, arg := range args {
+		artifact, err := domain.ParseArtifact(arg)
+		if err != nil {
+			if !hasDestPath {
+				artifacts[len(artifacts)-1].DestPath = arg
+				hasDestPath = true
+				continue
+			}
+			return nil, errors.Wrapf(err, "parse artifact name %s", arg)
+		}
+		artifacts = append(artifacts, builder.ArtifactOutput{Artifact: artifact, DestPath: "./"})
+		hasDestPath = false
+	}
+	return artifacts, nil
+}
+
 func (app *earthlyApp) newBuildkitdClient(ctx context.Context, opts ...client.ClientOpt) (*client.Client, string, error) {
 	if app.buildkitHost == "" {
 		// Start our own.
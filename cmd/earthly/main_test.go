@@ -0,0 +1,69 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParseTargets(t *testing.T) {
+	var tests = []struct {
+		args     []string
+		expected []string
+		ok       bool
+	}{
+		{[]string{"+build"}, []string{"+build"}, true},
+		{[]string{"+build", "./sub+test"}, []string{"+build", "./sub+test"}, true},
+		{[]string{"+lib+image"}, []string{"+lib+image"}, true},
+		{[]string{"github.com/foo/bar:v1+build"}, []string{"github.com/foo/bar:v1+build"}, true},
+		{[]string{"+build", "build"}, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			targets, err := parseTargets(tt.args)
+			if !tt.ok {
+				Error(t, err)
+				return
+			}
+			NoError(t, err)
+			var actual []string
+			for _, target := range targets {
+				actual = append(actual, target.String())
+			}
+			Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestParseArtifactOutputs(t *testing.T) {
+	var tests = []struct {
+		args     []string
+		expected []string // artifact and dest path pairs
+		ok       bool
+	}{
+		{[]string{"+build/out"}, []string{"+build/out", "./"}, true},
+		{[]string{"+build/out", "dist/"}, []string{"+build/out", "dist/"}, true},
+		{[]string{"+build/out", "+test/report.xml", "./reports/"}, []string{"+build/out", "./", "+test/report.xml", "./reports/"}, true},
+		{[]string{"./sub+build/out", "../out"}, []string{"./sub+build/out", "../out"}, true},
+		{[]string{"+lib+build/out", "out"}, []string{"+lib+build/out", "out"}, true},
+		{[]string{"dist/"}, nil, false},
+		{[]string{"+build"}, nil, false},
+		{[]string{"+build/out", "dist/", "other/"}, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			artifacts, err := parseArtifactOutputs(tt.args)
+			if !tt.ok {
+				Error(t, err)
+				return
+			}
+			NoError(t, err)
+			var actual []string
+			for _, ao := range artifacts {
+				actual = append(actual, ao.Artifact.String(), ao.DestPath)
+			}
+			Equal(t, tt.expected, actual)
+		})
+	}
+}
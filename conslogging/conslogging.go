@@ -55,6 +55,7 @@ type ConsoleLogger struct {
 	errW           io.Writer
 	trailingLine   bool
 	prefixPadding  int
+	group          *groupState
 }
 
 // Current returns the current console.
@@ -84,9 +85,19 @@ func (cl ConsoleLogger) clone() ConsoleLogger {
 		nextColorIndex: cl.nextColorIndex,
 		prefixPadding:  cl.prefixPadding,
 		mu:             cl.mu,
+		group:          cl.group,
 	}
 }
 
+// WithWriters returns a ConsoleLogger which prints to the given writers, instead of
+// stdout and stderr.
+func (cl ConsoleLogger) WithWriters(outW io.Writer, errW io.Writer) ConsoleLogger {
+	ret := cl.clone()
+	ret.outW = outW
+	ret.errW = errW
+	return ret
+}
+
 // WithPrefix returns a ConsoleLogger with a prefix added.
 func (cl ConsoleLogger) WithPrefix(prefix string) ConsoleLogger {
 	ret := cl.clone()
@@ -133,6 +144,7 @@ func (cl ConsoleLogger) WithFailed(isFailed bool) ConsoleLogger {
 func (cl ConsoleLogger) PrintSuccess() {
 	cl.mu.Lock()
 	defer cl.mu.Unlock()
+	cl.endGroup(cl.outW)
 	cl.color(successColor).Fprintf(cl.outW, "=========================== SUCCESS ===========================\n")
 }
 
@@ -140,6 +152,7 @@ func (cl ConsoleLogger) PrintSuccess() {
 func (cl ConsoleLogger) PrintFailure() {
 	cl.mu.Lock()
 	defer cl.mu.Unlock()
+	cl.endGroup(cl.outW)
 	cl.color(warnColor).Fprintf(cl.outW, "=========================== FAILURE ===========================\n")
 }
 
@@ -224,6 +237,7 @@ func (cl ConsoleLogger) printPrefix(useErrWriter bool) {
 	}
 
 	// Assumes mu locked.
+	cl.updateGroup(w)
 	if cl.prefix == "" {
 		return
 	}
@@ -0,0 +1,141 @@
+package conslogging
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// GroupStyle is the style of the markers grouping the output of each target, for CI systems
+// which render the groups as collapsible sections.
+type GroupStyle int
+
+const (
+	// NoGroups prints the output without any group markers.
+	NoGroups GroupStyle = iota
+	// GitHubGroups uses the ::group:: and ::endgroup:: workflow commands of GitHub Actions.
+	GitHubGroups
+	// GitLabGroups uses the section_start and section_end markers of GitLab CI.
+	GitLabGroups
+	// BuildkiteGroups uses the --- group headers of Buildkite.
+	BuildkiteGroups
+)
+
+// GroupStyleForCI returns the group style supported by the given CI system, as named by
+// analytics.DetectCI.
+func GroupStyleForCI(ciName string) GroupStyle {
+	switch ciName {
+	case "github-actions":
+		return GitHubGroups
+	case "gitlab":
+		return GitLabGroups
+	case "buildkite":
+		return BuildkiteGroups
+	default:
+		return NoGroups
+	}
+}
+
+// groupState is the group currently open, shared between the instances of a console.
+type groupState struct {
+	style  GroupStyle
+	open   bool
+	prefix string
+	salt   string
+	count  int
+}
+
+// WithGroupStyle returns a ConsoleLogger which groups the consecutive lines printed for the
+// same target, using markers of the given style. The lines are not buffered: when the output of
+// targets running in parallel is interleaved, each target gets a group per run of consecutive
+// lines.
+func (cl ConsoleLogger) WithGroupStyle(style GroupStyle) ConsoleLogger {
+	ret := cl.clone()
+	ret.group = nil
+	if style != NoGroups {
+		ret.group = &groupState{style: style}
+	}
+	return ret
+}
+
+// GroupStyle returns the style of the group markers printed by the console.
+func (cl ConsoleLogger) GroupStyle() GroupStyle {
+	if cl.group == nil {
+		return NoGroups
+	}
+	return cl.group.style
+}
+
+// PrintErrorAnnotation prints an annotation pointing at the given line of a file, for the CI
+// systems which support annotations in their logs (currently GitHub Actions).
+func (cl ConsoleLogger) PrintErrorAnnotation(file string, line int, msg string) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	if cl.group == nil || cl.group.style != GitHubGroups {
+		return
+	}
+	cl.endGroup(cl.outW)
+	fmt.Fprintf(cl.outW, "::error file=%s,line=%d::%s\n", file, line, escapeGitHubData(msg))
+}
+
+// updateGroup starts a new group if the current one is for another target. The markers are
+// written to w, the writer of the line about to be printed. Assumes mu locked.
+func (cl ConsoleLogger) updateGroup(w io.Writer) {
+	g := cl.group
+	if g == nil {
+		return
+	}
+	if g.open && g.prefix == cl.prefix && g.salt == cl.salt {
+		return
+	}
+	cl.endGroup(w)
+	if cl.prefix == "" {
+		return
+	}
+	g.count++
+	switch g.style {
+	case GitHubGroups:
+		fmt.Fprintf(w, "::group::%s\n", cl.prefix)
+	case GitLabGroups:
+		fmt.Fprintf(w, "\x1b[0Ksection_start:%d:earthly_%d[collapsed=true]\r\x1b[0K%s\n",
+			time.Now().Unix(), g.count, cl.prefix)
+	case BuildkiteGroups:
+		fmt.Fprintf(w, "--- %s\n", cl.prefix)
+	}
+	g.open = true
+	g.prefix = cl.prefix
+	g.salt = cl.salt
+}
+
+// endGroup ends the current group, if any, writing the marker to w. Assumes mu locked.
+func (cl ConsoleLogger) endGroup(w io.Writer) {
+	g := cl.group
+	if g == nil || !g.open {
+		return
+	}
+	switch g.style {
+	case GitHubGroups:
+		fmt.Fprintf(w, "::endgroup::\n")
+	case GitLabGroups:
+		fmt.Fprintf(w, "\x1b[0Ksection_end:%d:earthly_%d\r\x1b[0K\n", time.Now().Unix(), g.count)
+	}
+	g.open = false
+}
+
+// escapeGitHubData escapes the message of a GitHub workflow command.
+func escapeGitHubData(s string) string {
+	out := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '%':
+			out = append(out, "%25"...)
+		case '\r':
+			out = append(out, "%0D"...)
+		case '\n':
+			out = append(out, "%0A"...)
+		default:
+			out = append(out, s[i])
+		}
+	}
+	return string(out)
+}
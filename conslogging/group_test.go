@@ -0,0 +1,85 @@
+package conslogging
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestGroupStyleForCI(t *testing.T) {
+	var tests = []struct {
+		ciName   string
+		expected GroupStyle
+	}{
+		{"github-actions", GitHubGroups},
+		{"gitlab", GitLabGroups},
+		{"buildkite", BuildkiteGroups},
+		{"circle-ci", NoGroups},
+		{"", NoGroups},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ciName, func(t *testing.T) {
+			Equal(t, tt.expected, GroupStyleForCI(tt.ciName))
+		})
+	}
+}
+
+func TestEscapeGitHubData(t *testing.T) {
+	var tests = []struct {
+		data     string
+		expected string
+	}{
+		{"exit code 1", "exit code 1"},
+		{"100% done", "100%25 done"},
+		{"line 1\nline 2\r\n", "line 1%0Aline 2%0D%0A"},
+		{"::endgroup::", "::endgroup::"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			Equal(t, tt.expected, escapeGitHubData(tt.data))
+		})
+	}
+}
+
+func TestGroupMarkers(t *testing.T) {
+	var outW, errW bytes.Buffer
+	cl := Current(NoColor, NoPadding).WithWriters(&outW, &errW).WithGroupStyle(GitHubGroups)
+	a := cl.WithPrefix("+a")
+	b := cl.WithPrefix("+b")
+	a.Printf("building\n")
+	a.Warnf("warning\n")
+	b.Warnf("failed\n")
+	b.Printf("output\n")
+	cl.PrintErrorAnnotation("Earthfile", 3, "+b: RUN false: exit code 1")
+	Equal(t, "::group::+a\n"+
+		"+a | building\n"+
+		"+b | output\n"+
+		"::endgroup::\n"+
+		"::error file=Earthfile,line=3::+b: RUN false: exit code 1\n", outW.String())
+	Equal(t, "+a | warning\n"+
+		"::endgroup::\n"+
+		"::group::+b\n"+
+		"+b | failed\n", errW.String())
+}
+
+func TestGroupMarkersInterleaved(t *testing.T) {
+	var outW bytes.Buffer
+	cl := Current(NoColor, NoPadding).WithWriters(&outW, &outW).WithGroupStyle(BuildkiteGroups)
+	a := cl.WithPrefix("+a")
+	b := cl.WithPrefix("+b")
+	a.Printf("a1\n")
+	a.Printf("a2\n")
+	b.Printf("b1\n")
+	a.Printf("a3\n")
+	b.Printf("b2\n")
+	Equal(t, "--- +a\n"+
+		"+a | a1\n"+
+		"+a | a2\n"+
+		"--- +b\n"+
+		"+b | b1\n"+
+		"--- +a\n"+
+		"+a | a3\n"+
+		"--- +b\n"+
+		"+b | b2\n", outW.String())
+}
@@ -87,9 +87,6 @@ func Docker2Earthly(dockerfilePath, EarthfilePath, imageTag string) error {
 				l = fmt.Sprintf("COPY +subbuild%d/%s %s", n+1, artifactName, parts[3])
 				targets[n+1] = append(targets[n+1], fmt.Sprintf("SAVE ARTIFACT %s %s\n", parts[2], artifactName))
 			}
-			if strings.HasPrefix(l, "ADD ") {
-				return fmt.Errorf("earthly does not support ADD, please convert to COPY instead")
-			}
 			targets[i+1] = append(targets[i+1], l)
 		}
 	}
@@ -30,14 +30,21 @@ func ParseArtifact(artifactName string) (Artifact, error) {
 	if err != nil {
 		return Artifact{}, err
 	}
-	if len(parts) != 2 {
+	var targetPrefix string
+	switch {
+	case len(parts) == 2:
+		targetPrefix = escapePlus(parts[0])
+	case len(parts) == 3 && parts[0] == "":
+		// Import reference (+alias+target/artifact).
+		targetPrefix = fmt.Sprintf("+%s", escapePlus(parts[1]))
+	default:
 		return Artifact{}, errors.Errorf("invalid artifact name %s", artifactName)
 	}
-	partsSlash := strings.SplitN(parts[1], "/", 2)
+	partsSlash := strings.SplitN(parts[len(parts)-1], "/", 2)
 	if len(partsSlash) != 2 {
 		return Artifact{}, errors.Errorf("invalid artifact name %s", artifactName)
 	}
-	earthTargetName := fmt.Sprintf("%s+%s", escapePlus(parts[0]), partsSlash[0])
+	earthTargetName := fmt.Sprintf("%s+%s", targetPrefix, partsSlash[0])
 	target, err := ParseTarget(earthTargetName)
 	if err != nil {
 		return Artifact{}, errors.Wrapf(err, "invalid artifact name %s", artifactName)
@@ -24,6 +24,8 @@ var targetTests = []struct {
 	{"../rel/local/dir-with-\\+-in+target", Target{Target: "target", LocalPath: "../rel/local/dir-with-+-in"}},
 	{"github.com/foo/bar/dir-with-\\+-in+target", Target{Target: "target", GitURL: "github.com/foo/bar/dir-with-+-in"}},
 	{"github.com/foo/bar:tag-with-\\+-in+target", Target{Target: "target", GitURL: "github.com/foo/bar", Tag: "tag-with-+-in"}},
+	// Import references
+	{"+proto+target", Target{Target: "target", ImportRef: "proto"}},
 }
 
 func TestTargetParser(t *testing.T) {
@@ -73,6 +75,9 @@ var artifactTests = []struct {
 	{"../rel/local/dir-with-\\+-in+target/artifact-with-\\+/in/it", Artifact{Target: Target{Target: "target", LocalPath: "../rel/local/dir-with-+-in"}, Artifact: "/artifact-with-+/in/it"}},
 	{"github.com/foo/bar/dir-with-\\+-in+target/artifact-with-\\+/in/it", Artifact{Target: Target{Target: "target", GitURL: "github.com/foo/bar/dir-with-+-in"}, Artifact: "/artifact-with-+/in/it"}},
 	{"github.com/foo/bar:tag-with-\\+-in+target/artifact-with-\\+/in/it", Artifact{Target: Target{Target: "target", GitURL: "github.com/foo/bar", Tag: "tag-with-+-in"}, Artifact: "/artifact-with-+/in/it"}},
+	// Import references
+	{"+proto+target/artifact", Artifact{Target: Target{Target: "target", ImportRef: "proto"}, Artifact: "/artifact"}},
+	{"+proto+target/deep/artifact", Artifact{Target: Target{Target: "target", ImportRef: "proto"}, Artifact: "/deep/artifact"}},
 }
 
 func TestArtifactParser(t *testing.T) {
@@ -93,3 +98,41 @@ func TestArtifactToString(t *testing.T) {
 		})
 	}
 }
+
+func TestImportTracker(t *testing.T) {
+	var tests = []struct {
+		importStr string
+		as        string
+		ref       string
+		out       Target
+	}{
+		{"github.com/foo/bar/proto:v1.4.2", "", "+proto+build", Target{Target: "build", GitURL: "github.com/foo/bar/proto", Tag: "v1.4.2"}},
+		{"github.com/foo/bar", "lib", "+lib+build", Target{Target: "build", GitURL: "github.com/foo/bar"}},
+		{"./libs/proto", "", "+proto+build", Target{Target: "build", LocalPath: "./libs/proto"}},
+		{"../sibling", "sib", "+sib+build", Target{Target: "build", LocalPath: "../sibling"}},
+		{"github.com/foo/bar", "", "+other", Target{Target: "other", LocalPath: "."}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			it := NewImportTracker()
+			err := it.Add(tt.importStr, tt.as)
+			NoError(t, err, "add import failed")
+			target, err := ParseTarget(tt.ref)
+			NoError(t, err, "parse target failed")
+			out, err := it.Deref(target)
+			NoError(t, err, "deref failed")
+			Equal(t, tt.out, out)
+		})
+	}
+}
+
+func TestImportTrackerErrors(t *testing.T) {
+	it := NewImportTracker()
+	NoError(t, it.Add("github.com/foo/bar", "bar"))
+	Error(t, it.Add("github.com/foo/baz", "bar"))
+	Error(t, it.Add("github.com/foo/bar+target", "x"))
+	Error(t, it.Add(".", ""))
+	Error(t, it.Add("github.com/foo/baz", "in/valid"))
+	_, err := it.Deref(Target{Target: "build", ImportRef: "unknown"})
+	Error(t, err)
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ImportTracker keeps track of the imports declared in an Earthfile (via IMPORT ... AS ...)
+// and resolves the targets which refer to them.
+type ImportTracker struct {
+	imports map[string]string // alias -> project reference
+}
+
+// NewImportTracker creates a new import tracker.
+func NewImportTracker() *ImportTracker {
+	return &ImportTracker{
+		imports: make(map[string]string),
+	}
+}
+
+// Add registers an import. If as is empty, the alias is derived from the last element of the
+// path of the project reference (e.g. "proto" for "github.com/foo/bar/proto:v1.2.3").
+func (it *ImportTracker) Add(importStr string, as string) error {
+	if importStr == "" || strings.Contains(importStr, "+") {
+		return errors.Errorf("invalid import %s: expected a project reference, without a target", importStr)
+	}
+	_, err := ParseTarget(fmt.Sprintf("%s+base", importStr))
+	if err != nil {
+		return errors.Wrapf(err, "invalid import %s", importStr)
+	}
+	if as == "" {
+		as = importAlias(importStr)
+		if as == "" {
+			return errors.Errorf("could not determine the alias of import %s; use IMPORT %s AS <alias>", importStr, importStr)
+		}
+	}
+	if strings.ContainsAny(as, "+/:") {
+		return errors.Errorf("invalid import alias %s", as)
+	}
+	_, exists := it.imports[as]
+	if exists {
+		return errors.Errorf("import alias %s is declared twice", as)
+	}
+	it.imports[as] = importStr
+	return nil
+}
+
+// Deref resolves the import reference of the target, if any. Targets which do not refer to an
+// import are returned as-is.
+func (it *ImportTracker) Deref(target Target) (Target, error) {
+	if !target.IsImportReference() {
+		return target, nil
+	}
+	importStr, found := it.imports[target.ImportRef]
+	if !found {
+		return Target{}, errors.Errorf(
+			"import reference %s could not be resolved; no IMPORT declared as %s", target.String(), target.ImportRef)
+	}
+	return ParseTarget(fmt.Sprintf("%s+%s", importStr, escapePlus(target.Target)))
+}
+
+func importAlias(importStr string) string {
+	p := importStr
+	if !strings.HasPrefix(p, ".") && !strings.HasPrefix(p, "/") {
+		// Remote - strip the tag.
+		p = strings.SplitN(p, ":", 2)[0]
+	}
+	base := path.Base(path.Clean(p))
+	if base == "." || base == ".." || base == "/" {
+		return ""
+	}
+	return base
+}
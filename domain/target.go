@@ -16,6 +16,10 @@ type Target struct {
 	// Local representation.
 	LocalPath string `json:"localPath"`
 
+	// Import reference, e.g. "proto" (as declared via IMPORT ... AS proto). Targets using an
+	// import reference need to be dereferenced via an ImportTracker before use.
+	ImportRef string `json:"importRef,omitempty"`
+
 	// Target name.
 	Target string `json:"target"`
 }
@@ -37,16 +41,24 @@ func (et Target) IsLocalExternal() bool {
 
 // IsRemote returns whether the target is remote.
 func (et Target) IsRemote() bool {
-	return !et.IsLocalExternal() && !et.IsLocalInternal()
+	return !et.IsLocalExternal() && !et.IsLocalInternal() && !et.IsImportReference()
+}
+
+// IsImportReference returns whether the target refers to an import alias.
+func (et Target) IsImportReference() bool {
+	return et.ImportRef != ""
 }
 
 // DebugString returns a string that can be printed out for debugging purposes
 func (et Target) DebugString() string {
-	return fmt.Sprintf("gitURL: %q; tag: %q; LocalPath: %q; Target: %q", et.GitURL, et.Tag, et.LocalPath, et.Target)
+	return fmt.Sprintf("gitURL: %q; tag: %q; LocalPath: %q; ImportRef: %q; Target: %q", et.GitURL, et.Tag, et.LocalPath, et.ImportRef, et.Target)
 }
 
 // String returns a string representation of the Target.
 func (et Target) String() string {
+	if et.IsImportReference() {
+		return fmt.Sprintf("+%s+%s", escapePlus(et.ImportRef), escapePlus(et.Target))
+	}
 	if et.IsLocalExternal() {
 		return fmt.Sprintf("%s+%s", escapePlus(et.LocalPath), et.Target)
 	}
@@ -84,6 +96,9 @@ func (et Target) ProjectCanonical() string {
 		}
 		return s
 	}
+	if et.IsImportReference() {
+		return "+" + escapePlus(et.ImportRef)
+	}
 	if et.LocalPath == "." {
 		return ""
 	}
@@ -96,6 +111,13 @@ func ParseTarget(fullTargetName string) (Target, error) {
 	if err != nil {
 		return Target{}, err
 	}
+	if len(partsPlus) == 3 && partsPlus[0] == "" && partsPlus[1] != "" {
+		// Import reference (+alias+target).
+		return Target{
+			ImportRef: partsPlus[1],
+			Target:    partsPlus[2],
+		}, nil
+	}
 	if len(partsPlus) != 2 {
 		return Target{}, fmt.Errorf("invalid target ref %s", fullTargetName)
 	}
@@ -19,5 +19,25 @@ func NewReturnErrorListener() *ReturnErrorListener {
 
 // SyntaxError implements ErrorListener SyntaxError.
 func (rel *ReturnErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	rel.Errs = append(rel.Errs, fmt.Errorf("syntax error: line %d:%d %s", line, column, msg))
+	se := &SyntaxError{Line: line, Column: column, Msg: msg}
+	token, ok := offendingSymbol.(antlr.Token)
+	if ok && token.GetTokenType() != antlr.TokenEOF {
+		se.Token = token.GetText()
+	}
+	rel.Errs = append(rel.Errs, se)
+}
+
+// SyntaxError is an error reported by the lexer or the parser, along with its position.
+type SyntaxError struct {
+	// Line is the line of the error, starting at 1.
+	Line int
+	// Column is the column of the error, starting at 0.
+	Column int
+	// Token is the text of the offending token, if any.
+	Token string
+	Msg   string
+}
+
+func (se *SyntaxError) Error() string {
+	return fmt.Sprintf("syntax error: line %d:%d %s", se.Line, se.Column, se.Msg)
 }
@@ -0,0 +1,135 @@
+package earthfile2llb
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/earthly/earthly/variables"
+)
+
+var varRefRegexp = regexp.MustCompile(`\$(\{[a-zA-Z_][a-zA-Z0-9_]*\}|[a-zA-Z_][a-zA-Z0-9_]*)`)
+
+// forExpansionArgs returns the shell command writing the expansion of the expression of a FOR
+// command to outputPath. Field splitting is disabled (IFS is empty), such that the output of a
+// command substitution is written as is, for the separators of the FOR to split it. Globs are
+// still expanded, and the words are joined with a space.
+func forExpansionArgs(expression []string, outputPath string) []string {
+	args := []string{"IFS=''", ";", "set", "--"}
+	args = append(args, expression...)
+	return append(args, ";", "IFS=' '", ";", "printf", "'%s'", "\"$*\"", ">", outputPath)
+}
+
+// parseSeparators returns the separators of a FOR --sep flag. Surrounding quotes are removed
+// and the escape sequences \n, \t, \r and \\ are interpreted.
+func parseSeparators(sep string) string {
+	if len(sep) >= 2 && (sep[0] == '"' || sep[0] == '\'') && sep[len(sep)-1] == sep[0] {
+		sep = sep[1 : len(sep)-1]
+	}
+	return strings.NewReplacer(`\n`, "\n", `\t`, "\t", `\r`, "\r", `\\`, `\`).Replace(sep)
+}
+
+// evalConstantCondition attempts to evaluate a shell condition without executing it, when
+// it is a simple test (e.g. [ "$SOME_ARG" = "value" ]) involving only constant variables.
+// The second return value is false if the condition cannot be evaluated this way.
+func evalConstantCondition(words []string, varCollection *variables.Collection) (bool, bool) {
+	expanded, ok := expandConstantWords(words, varCollection)
+	if !ok {
+		return false, false
+	}
+	return evalTest(expanded)
+}
+
+// expandConstantWords expands the given words, provided that they only involve constant
+// variables. The second return value is false if any of the words cannot be expanded exactly.
+func expandConstantWords(words []string, varCollection *variables.Collection) ([]string, bool) {
+	expanded := make([]string, 0, len(words))
+	for _, word := range words {
+		value, ok := expandConstantWord(word, varCollection)
+		if !ok {
+			return nil, false
+		}
+		expanded = append(expanded, value)
+	}
+	return expanded, true
+}
+
+func evalTest(words []string) (bool, bool) {
+	if len(words) > 0 && words[0] == "!" {
+		result, ok := evalTest(words[1:])
+		return !result, ok
+	}
+	if len(words) == 1 {
+		switch words[0] {
+		case "true":
+			return true, true
+		case "false":
+			return false, true
+		}
+		return false, false
+	}
+	switch {
+	case len(words) >= 2 && words[0] == "[" && words[len(words)-1] == "]":
+		words = words[1 : len(words)-1]
+	case len(words) >= 1 && words[0] == "test":
+		words = words[1:]
+	default:
+		return false, false
+	}
+	if len(words) > 0 && words[0] == "!" {
+		result, ok := evalTestExpression(words[1:])
+		return !result, ok
+	}
+	return evalTestExpression(words)
+}
+
+func evalTestExpression(words []string) (bool, bool) {
+	switch len(words) {
+	case 0:
+		return false, true
+	case 1:
+		return words[0] != "", true
+	case 2:
+		switch words[0] {
+		case "-z":
+			return words[1] == "", true
+		case "-n":
+			return words[1] != "", true
+		}
+	case 3:
+		switch words[1] {
+		case "=", "==":
+			return words[0] == words[2], true
+		case "!=":
+			return words[0] != words[2], true
+		}
+	}
+	return false, false
+}
+
+// expandConstantWord expands the variables within the word, provided that they are all
+// active constants. The second return value is false if the word cannot be expanded exactly
+// (e.g. because it contains a non-constant variable, a command substitution or a glob).
+func expandConstantWord(word string, varCollection *variables.Collection) (string, bool) {
+	if strings.ContainsAny(word, "`*?~\\") {
+		return "", false
+	}
+	refs := varRefRegexp.FindAllStringSubmatch(word, -1)
+	if len(refs) != strings.Count(word, "$") {
+		// Command substitutions, special parameters etc.
+		return "", false
+	}
+	for _, ref := range refs {
+		name := strings.TrimSuffix(strings.TrimPrefix(ref[1], "{"), "}")
+		variable, active, found := varCollection.Get(name)
+		if !found || !active || !variable.IsConstant() {
+			return "", false
+		}
+	}
+	value := varCollection.Expand(word)
+	quoted := strings.HasPrefix(word, "\"") && strings.HasSuffix(word, "\"")
+	if !quoted && len(refs) > 0 && strings.ContainsAny(value, " \t\n") {
+		// Would be subject to word splitting in the shell.
+		return "", false
+	}
+	return value, true
+}
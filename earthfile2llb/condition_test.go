@@ -0,0 +1,140 @@
+package earthfile2llb
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/earthly/earthly/variables"
+	. "github.com/stretchr/testify/assert"
+)
+
+func testConditionVars() *variables.Collection {
+	vars := variables.NewCollection()
+	vars.AddActive("FOO", variables.NewConstant("bar"), true, false)
+	vars.AddActive("SPACED", variables.NewConstant("a b"), true, false)
+	vars.AddActive("EMPTY", variables.NewConstant(""), true, false)
+	return vars
+}
+
+func TestExpandConstantWord(t *testing.T) {
+	var tests = []struct {
+		word     string
+		expected string
+		ok       bool
+	}{
+		{"plain", "plain", true},
+		{"$FOO", "bar", true},
+		{"${FOO}-x", "bar-x", true},
+		{"\"$FOO\"", "bar", true},
+		{"'quoted'", "quoted", true},
+		{"\"$SPACED\"", "a b", true},
+		{"$SPACED", "", false},
+		{"$MISSING", "", false},
+		{"$(cat file)", "", false},
+		{"`cat file`", "", false},
+		{"$1", "", false},
+		{"*.go", "", false},
+		{"~/file", "", false},
+	}
+	vars := testConditionVars()
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			actual, ok := expandConstantWord(tt.word, vars)
+			Equal(t, tt.ok, ok)
+			Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestEvalConstantCondition(t *testing.T) {
+	var tests = []struct {
+		condition []string
+		expected  bool
+		ok        bool
+	}{
+		{[]string{"true"}, true, true},
+		{[]string{"false"}, false, true},
+		{[]string{"!", "false"}, true, true},
+		{[]string{"[", "$FOO", "=", "bar", "]"}, true, true},
+		{[]string{"[", "\"$FOO\"", "==", "baz", "]"}, false, true},
+		{[]string{"[", "\"$FOO\"", "!=", "baz", "]"}, true, true},
+		{[]string{"[", "!", "$FOO", "=", "bar", "]"}, false, true},
+		{[]string{"!", "[", "-z", "$FOO", "]"}, true, true},
+		{[]string{"[", "-n", "\"$EMPTY\"", "]"}, false, true},
+		{[]string{"test", "-z", "\"$EMPTY\""}, true, true},
+		{[]string{"[", "\"$SPACED\"", "=", "\"a b\"", "]"}, true, true},
+		{[]string{"[", "$SPACED", "=", "\"a b\"", "]"}, false, false},
+		{[]string{"[", "$MISSING", "=", "x", "]"}, false, false},
+		{[]string{"[", "$(cat file)", "=", "x", "]"}, false, false},
+		{[]string{"[", "-f", "/file", "]"}, false, false},
+		{[]string{"grep", "x", "file"}, false, false},
+	}
+	vars := testConditionVars()
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.condition, " "), func(t *testing.T) {
+			actual, ok := evalConstantCondition(tt.condition, vars)
+			Equal(t, tt.ok, ok)
+			Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestForExpansionArgs(t *testing.T) {
+	var tests = []struct {
+		expression []string
+		separators string
+		expected   []string
+	}{
+		{[]string{"hello", "world"}, " \t\n", []string{"hello", "world"}},
+		{[]string{"$(printf 'a b\\nc\\td\\n')"}, "\n", []string{"a b", "c\td"}},
+		{[]string{"$(printf 'a  b\\n\\n c ')"}, "\n", []string{"a  b", " c "}},
+		{[]string{"x", "$(printf 'y z')"}, " ", []string{"x", "y", "z"}},
+		{[]string{"\"$(printf 'p,q r')\""}, ",", []string{"p", "q r"}},
+	}
+	dir, err := ioutil.TempDir("", "earthly-for")
+	if !NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+	outputPath := filepath.Join(dir, "output")
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.expression, " "), func(t *testing.T) {
+			args := forExpansionArgs(tt.expression, outputPath)
+			err := exec.Command("/bin/sh", "-c", strings.Join(args, " ")).Run()
+			if !NoError(t, err) {
+				return
+			}
+			dt, err := ioutil.ReadFile(outputPath)
+			if !NoError(t, err) {
+				return
+			}
+			actual := strings.FieldsFunc(string(dt), func(r rune) bool {
+				return strings.ContainsRune(tt.separators, r)
+			})
+			Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func TestParseSeparators(t *testing.T) {
+	var tests = []struct {
+		sep      string
+		expected string
+	}{
+		{",", ","},
+		{"\"\\n\"", "\n"},
+		{"'\\t'", "\t"},
+		{"\\n\\t ", "\n\t "},
+		{"\\\\", "\\"},
+		{"\"", "\""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sep, func(t *testing.T) {
+			Equal(t, tt.expected, parseSeparators(tt.sep))
+		})
+	}
+}
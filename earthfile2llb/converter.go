@@ -10,6 +10,7 @@ import (
 	"hash/fnv"
 	"io/ioutil"
 	"math/rand"
+	"net/url"
 	"path"
 	"strings"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/earthly/earthly/buildcontext"
 	"github.com/earthly/earthly/debugger/common"
 	"github.com/earthly/earthly/domain"
+	"github.com/earthly/earthly/features"
 	"github.com/earthly/earthly/gitutil"
 	"github.com/earthly/earthly/llbutil"
 	"github.com/earthly/earthly/states"
@@ -29,6 +31,7 @@ import (
 	"github.com/moby/buildkit/frontend/dockerfile/dockerfile2llb"
 	gwclient "github.com/moby/buildkit/frontend/gateway/client"
 	solverpb "github.com/moby/buildkit/solver/pb"
+	"github.com/opencontainers/go-digest"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
@@ -45,6 +48,20 @@ type Converter struct {
 	varCollection    *variables.Collection
 	nextArgIndex     int
 	ranSave          bool
+	commandScopes    []commandScope
+	forScopes        []*variables.Collection
+	imports          *domain.ImportTracker
+	// locally is set when the target executes its commands on the host (LOCALLY).
+	locally bool
+}
+
+// commandScope holds the state of the caller of a user-defined command, to be restored once
+// the command finishes executing.
+type commandScope struct {
+	command       domain.Target
+	varCollection *variables.Collection
+	imports       *domain.ImportTracker
+	features      *features.Features
 }
 
 // NewConverter constructs a new converter for a given earthly target.
@@ -81,6 +98,7 @@ func NewConverter(ctx context.Context, target domain.Target, bc *buildcontext.Da
 		cacheContext: makeCacheContext(target),
 		varCollection: opt.VarCollection.WithBuiltinBuildArgs(
 			target, llbutil.PlatformWithDefault(opt.Platform), bc.GitMetadata),
+		imports: domain.NewImportTracker(),
 	}, nil
 }
 
@@ -267,6 +285,9 @@ func (c *Converter) CopyArtifact(ctx context.Context, artifactName string, dest
 	if artifact.Target.IsLocalInternal() {
 		artifact.Target.LocalPath = c.mts.Final.Target.LocalPath
 	}
+	if c.locally {
+		return c.copyArtifactLocally(ctx, mts, artifact, dest, isDir, keepTs, ifExists)
+	}
 	// Grab the artifacts state in the dep states, after we've built it.
 	relevantDepState := mts.Final
 	// Copy.
@@ -285,7 +306,10 @@ func (c *Converter) CopyArtifact(ctx context.Context, artifactName string, dest
 }
 
 // CopyClassical applies the earthly COPY command, with classical args.
-func (c *Converter) CopyClassical(ctx context.Context, srcs []string, dest string, isDir bool, keepTs bool, keepOwn bool, chown string) {
+func (c *Converter) CopyClassical(ctx context.Context, srcs []string, dest string, isDir bool, keepTs bool, keepOwn bool, chown string) error {
+	if c.locally {
+		return errors.New("COPY of build context files is not supported in LOCALLY, as the files are already available on the host")
+	}
 	c.nonSaveCommand()
 	c.mts.Final.MainState = llbutil.CopyOp(
 		c.buildContext, srcs, c.mts.Final.MainState, dest, true, isDir, keepTs, c.copyOwner(keepOwn, chown), false,
@@ -295,6 +319,69 @@ func (c *Converter) CopyClassical(ctx context.Context, srcs []string, dest strin
 			strIf(isDir, "--dir "),
 			strings.Join(srcs, " "),
 			dest))
+	return nil
+}
+
+// CopyHeredoc applies the earthly COPY command for a file created inline, via a heredoc.
+func (c *Converter) CopyHeredoc(ctx context.Context, dest string, content string, expand bool, keepTs bool, chown string) error {
+	if c.locally {
+		return errors.New("COPY of heredocs is not supported in LOCALLY")
+	}
+	c.nonSaveCommand()
+	if expand {
+		content = expandVarRefs(content, c.varCollection)
+	}
+	c.mts.Final.MainState = llbutil.MkfileOp(
+		c.mts.Final.MainState, dest, []byte(content), keepTs, c.copyOwner(false, chown),
+		llb.WithCustomNamef("%sCOPY <<heredoc %s", c.vertexPrefix(), dest))
+	return nil
+}
+
+// Add applies the earthly ADD command. Local tar archives are unpacked into the destination.
+// HTTP(S) URL sources are downloaded, but not unpacked.
+func (c *Converter) Add(ctx context.Context, srcs []string, dest string, checksum string, keepTs bool, chown string) error {
+	c.nonSaveCommand()
+	var localSrcs, urlSrcs []string
+	for _, src := range srcs {
+		if isURL(src) {
+			urlSrcs = append(urlSrcs, src)
+		} else {
+			localSrcs = append(localSrcs, src)
+		}
+	}
+	if checksum != "" && (len(urlSrcs) != 1 || len(localSrcs) != 0) {
+		return errors.New("ADD --checksum is only supported with a single URL source")
+	}
+	for _, src := range urlSrcs {
+		filename, err := urlFilename(src)
+		if err != nil {
+			return err
+		}
+		httpOpts := []llb.HTTPOption{
+			llb.Filename(filename),
+			llb.WithCustomNamef("%sADD %s", c.vertexPrefixWithURL(src), src),
+		}
+		if checksum != "" {
+			dgst, err := digest.Parse(checksum)
+			if err != nil {
+				return errors.Wrapf(err, "parse checksum %s", checksum)
+			}
+			httpOpts = append(httpOpts, llb.Checksum(dgst))
+		}
+		httpState := llb.HTTP(src, httpOpts...)
+		c.mts.Final.MainState = llbutil.CopyOp(
+			httpState, []string{filename}, c.mts.Final.MainState, dest, false, false, keepTs,
+			c.copyOwner(false, chown), false,
+			llb.WithCustomNamef("%sADD %s %s", c.vertexPrefix(), src, dest))
+	}
+	if len(localSrcs) > 0 {
+		c.mts.Final.MainState = llbutil.AddOp(
+			c.buildContext, localSrcs, c.mts.Final.MainState, dest, true, keepTs,
+			c.copyOwner(false, chown),
+			llb.WithCustomNamef(
+				"%sADD %s %s", c.vertexPrefix(), strings.Join(localSrcs, " "), dest))
+	}
+	return nil
 }
 
 // Run applies the earthly RUN command.
@@ -303,8 +390,11 @@ func (c *Converter) Run(ctx context.Context, args, mounts, secretKeyValues []str
 	if withDocker {
 		return errors.New("RUN --with-docker is obsolete. Please use WITH DOCKER ... RUN ... END instead")
 	}
+	if c.locally {
+		return c.runLocally(ctx, args, mounts, secretKeyValues, withEntrypoint, isWithShell, pushFlag)
+	}
 	var opts []llb.RunOption
-	mountRunOpts, err := parseMounts(mounts, c.mts.Final.Target, c.mts.Final.TargetInput, c.cacheContext)
+	mountRunOpts, err := c.parseMounts(ctx, mounts)
 	if err != nil {
 		return errors.Wrap(err, "parse mounts")
 	}
@@ -337,9 +427,17 @@ func (c *Converter) Run(ctx context.Context, args, mounts, secretKeyValues []str
 
 // SaveArtifact applies the earthly SAVE ARTIFACT command.
 func (c *Converter) SaveArtifact(ctx context.Context, saveFrom string, saveTo string, saveAsLocalTo string, keepTs bool, keepOwn bool, ifExists bool) error {
+	srcState := c.mts.Final.MainState
+	if c.locally {
+		var err error
+		srcState, err = c.localSaveState(saveFrom)
+		if err != nil {
+			return err
+		}
+	}
 	saveToAdjusted := saveTo
 	if saveTo == "" || saveTo == "." || strings.HasSuffix(saveTo, "/") {
-		absSaveFrom, err := llbutil.Abs(ctx, c.mts.Final.MainState, saveFrom)
+		absSaveFrom, err := llbutil.Abs(ctx, srcState, saveFrom)
 		if err != nil {
 			return err
 		}
@@ -363,14 +461,14 @@ func (c *Converter) SaveArtifact(ctx context.Context, saveFrom string, saveTo st
 		own = ""
 	}
 	c.mts.Final.ArtifactsState = llbutil.CopyOp(
-		c.mts.Final.MainState, []string{saveFrom}, c.mts.Final.ArtifactsState,
+		srcState, []string{saveFrom}, c.mts.Final.ArtifactsState,
 		saveToAdjusted, true, true, keepTs, own, ifExists,
 		llb.WithCustomNamef(
 			"%sSAVE ARTIFACT %s%s %s", c.vertexPrefix(), strIf(ifExists, "--if-exists "), saveFrom, artifact.String()))
 	if saveAsLocalTo != "" {
 		separateArtifactsState := llbutil.ScratchWithPlatform()
 		separateArtifactsState = llbutil.CopyOp(
-			c.mts.Final.MainState, []string{saveFrom}, separateArtifactsState,
+			srcState, []string{saveFrom}, separateArtifactsState,
 			saveToAdjusted, true, false, keepTs, "root:root", ifExists,
 			llb.WithCustomNamef(
 				"%sSAVE ARTIFACT %s%s %s AS LOCAL %s",
@@ -461,13 +559,13 @@ func (c *Converter) User(ctx context.Context, user string) {
 // Cmd applies the CMD command.
 func (c *Converter) Cmd(ctx context.Context, cmdArgs []string, isWithShell bool) {
 	c.nonSaveCommand()
-	c.mts.Final.MainImage.Config.Cmd = withShell(cmdArgs, isWithShell)
+	c.mts.Final.MainImage.Config.Cmd = withShell(cmdArgs, c.shell(), isWithShell)
 }
 
 // Entrypoint applies the ENTRYPOINT command.
 func (c *Converter) Entrypoint(ctx context.Context, entrypointArgs []string, isWithShell bool) {
 	c.nonSaveCommand()
-	c.mts.Final.MainImage.Config.Entrypoint = withShell(entrypointArgs, isWithShell)
+	c.mts.Final.MainImage.Config.Entrypoint = withShell(entrypointArgs, c.shell(), isWithShell)
 }
 
 // Expose applies the EXPOSE command.
@@ -556,6 +654,164 @@ func (c *Converter) Healthcheck(ctx context.Context, isNone bool, cmdArgs []stri
 	c.mts.Final.MainImage.Config.Healthcheck = hc
 }
 
+// If evaluates the condition of an IF (or ELSE IF) command. Simple tests involving only constant
+// args are evaluated directly. Otherwise, the condition is executed as a shell command in the
+// current build environment and its exit code is read back. Any changes the condition makes to
+// the build environment are discarded.
+func (c *Converter) If(ctx context.Context, condition []string, mounts, secretKeyValues []string, privileged, withSSH bool) (bool, error) {
+	if len(mounts) == 0 && len(secretKeyValues) == 0 {
+		result, ok := evalConstantCondition(condition, c.varCollection)
+		if ok {
+			return result, nil
+		}
+	}
+	c.nonSaveCommand()
+	ifStr := fmt.Sprintf(
+		"IF %s%s",
+		strIf(privileged, "--privileged "),
+		strings.Join(condition, " "))
+	exitCodePath := "/tmp/earthly-if-exit-code"
+	args := append([]string{"("}, condition...)
+	args = append(args, ")", ";", "echo", "$?", ">", exitCodePath)
+	exitCodeDt, err := c.runAndReadFile(
+		ctx, args, exitCodePath, mounts, secretKeyValues, privileged, withSSH, ifStr)
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(string(exitCodeDt)) == "0", nil
+}
+
+// For evaluates the expression of a FOR command and returns the resulting items, split by any
+// of the given separators. If the expression involves only constant args, it is evaluated
+// directly. Otherwise, it is expanded by the shell in the current build environment.
+func (c *Converter) For(ctx context.Context, expression []string, separators string, mounts, secretKeyValues []string, privileged, withSSH bool) ([]string, error) {
+	var output string
+	expanded, ok := expandConstantWords(expression, c.varCollection)
+	if ok && len(mounts) == 0 && len(secretKeyValues) == 0 {
+		output = strings.Join(expanded, " ")
+	} else {
+		c.nonSaveCommand()
+		forStr := fmt.Sprintf(
+			"FOR %s%s",
+			strIf(privileged, "--privileged "),
+			strings.Join(expression, " "))
+		outputPath := "/tmp/earthly-for-output"
+		outputDt, err := c.runAndReadFile(
+			ctx, forExpansionArgs(expression, outputPath), outputPath, mounts, secretKeyValues, privileged, withSSH, forStr)
+		if err != nil {
+			return nil, err
+		}
+		output = string(outputDt)
+	}
+	return strings.FieldsFunc(output, func(r rune) bool {
+		return strings.ContainsRune(separators, r)
+	}), nil
+}
+
+// EnterForIteration enters a new variable scope for an iteration of a FOR loop, with the loop
+// variable set to the given value.
+func (c *Converter) EnterForIteration(ctx context.Context, varName string, value string) {
+	c.forScopes = append(c.forScopes, c.varCollection)
+	c.varCollection = c.varCollection.WithResetEnvVars().WithEnvVarsFrom(c.varCollection)
+	c.varCollection.AddActive(varName, variables.NewConstant(value), true, false)
+}
+
+// ExitForIteration exits the variable scope of the current FOR loop iteration. Any env vars
+// declared within the iteration remain in effect.
+func (c *Converter) ExitForIteration(ctx context.Context) {
+	prevVarCollection := c.forScopes[len(c.forScopes)-1]
+	c.forScopes = c.forScopes[:len(c.forScopes)-1]
+	c.varCollection = prevVarCollection.WithResetEnvVars().WithEnvVarsFrom(c.varCollection)
+}
+
+// EnterCommand resolves a user-defined command and enters a new variable scope for its
+// execution. Only the build args provided are visible within the command as variables,
+// together with the env vars of the caller. It returns the command's target and the path
+// to the build file declaring it.
+func (c *Converter) EnterCommand(ctx context.Context, commandName string, buildArgs []string) (domain.Target, string, error) {
+	relCommand, err := domain.ParseTarget(commandName)
+	if err != nil {
+		return domain.Target{}, "", errors.Wrapf(err, "earthly command parse %s", commandName)
+	}
+	relCommand, err = c.imports.Deref(relCommand)
+	if err != nil {
+		return domain.Target{}, "", err
+	}
+	command, err := domain.JoinTargets(c.refTarget(), relCommand)
+	if err != nil {
+		return domain.Target{}, "", errors.Wrap(err, "join targets")
+	}
+	if command.Target == "base" {
+		return domain.Target{}, "", errors.New("the base target cannot be used as a command")
+	}
+	for _, scope := range c.commandScopes {
+		if scope.command.StringCanonical() == command.StringCanonical() {
+			return domain.Target{}, "", fmt.Errorf(
+				"infinite recursion detected for command %s", command.String())
+		}
+	}
+	bc, err := c.opt.Resolver.Resolve(ctx, c.opt.GwClient, command)
+	if err != nil {
+		return domain.Target{}, "", errors.Wrapf(err, "resolve build context for command %s", command.String())
+	}
+	newVarCollection, _, err := c.varCollection.WithParseBuildArgs(
+		buildArgs, c.processNonConstantBuildArgFunc(ctx), false)
+	if err != nil {
+		return domain.Target{}, "", errors.Wrap(err, "parse build args")
+	}
+	newVarCollection = newVarCollection.WithBuiltinBuildArgs(
+		c.mts.Final.Target, llbutil.PlatformWithDefault(c.opt.Platform), c.gitMeta)
+	c.commandScopes = append(c.commandScopes, commandScope{
+		command:       command,
+		varCollection: c.varCollection,
+		imports:       c.imports,
+		features:      c.opt.Features,
+	})
+	c.varCollection = newVarCollection.WithEnvVarsFrom(c.varCollection)
+	// The command sees only the imports and the VERSION declared in its own Earthfile.
+	c.imports = domain.NewImportTracker()
+	c.opt.Features = features.Default()
+	return bc.Target, bc.BuildFilePath, nil
+}
+
+// ExitCommand exits the variable scope of the innermost user-defined command. Any env vars
+// declared by the command remain in effect for the caller.
+func (c *Converter) ExitCommand(ctx context.Context) {
+	scope := c.commandScopes[len(c.commandScopes)-1]
+	c.commandScopes = c.commandScopes[:len(c.commandScopes)-1]
+	c.varCollection = scope.varCollection.WithResetEnvVars().WithEnvVarsFrom(c.varCollection)
+	c.imports = scope.imports
+	c.opt.Features = scope.features
+}
+
+// Version applies the earthly VERSION command, which determines the language features
+// available to the Earthfile.
+func (c *Converter) Version(ctx context.Context, versionStr string) error {
+	ftrs, err := features.Get(versionStr)
+	if err != nil {
+		return err
+	}
+	c.opt.Features = ftrs
+	return nil
+}
+
+// Import applies the earthly IMPORT command.
+func (c *Converter) Import(ctx context.Context, importStr string, as string) error {
+	return c.imports.Add(importStr, as)
+}
+
+// Shell applies the SHELL command.
+func (c *Converter) Shell(ctx context.Context, shell []string) {
+	c.nonSaveCommand()
+	c.mts.Final.MainImage.Config.Shell = shell
+}
+
+// Stopsignal applies the STOPSIGNAL command.
+func (c *Converter) Stopsignal(ctx context.Context, signal string) {
+	c.nonSaveCommand()
+	c.mts.Final.MainImage.Config.StopSignal = signal
+}
+
 // FinalizeStates returns the LLB states.
 func (c *Converter) FinalizeStates(ctx context.Context) (*states.MultiTarget, error) {
 	c.markFakeDeps()
@@ -576,7 +832,11 @@ func (c *Converter) buildTarget(ctx context.Context, fullTargetName string, plat
 	if err != nil {
 		return nil, errors.Wrapf(err, "earthly target parse %s", fullTargetName)
 	}
-	target, err := domain.JoinTargets(c.mts.Final.Target, relTarget)
+	relTarget, err = c.imports.Deref(relTarget)
+	if err != nil {
+		return nil, err
+	}
+	target, err := domain.JoinTargets(c.refTarget(), relTarget)
 	if err != nil {
 		return nil, errors.Wrap(err, "join targets")
 	}
@@ -694,7 +954,7 @@ func (c *Converter) internalRun(ctx context.Context, args, secretKeyValues []str
 		finalOpts = append(finalOpts, llb.AddSSHSocket())
 	}
 	// Shell and debugger wrap.
-	finalArgs := shellWrap(args, extraEnvVars, isWithShell, true)
+	finalArgs := shellWrap(args, extraEnvVars, c.shell(), isWithShell, true)
 	finalOpts = append(finalOpts, llb.Args(finalArgs))
 	if pushFlag {
 		// For push-flagged commands, make sure they run every time - don't use cache.
@@ -716,6 +976,46 @@ func (c *Converter) internalRun(ctx context.Context, args, secretKeyValues []str
 	return nil
 }
 
+// runAndReadFile executes the given shell command in the current build environment and returns
+// the contents of the file at outputPath, as written by the command. Any changes made by the
+// command to the build environment are discarded.
+func (c *Converter) runAndReadFile(ctx context.Context, args []string, outputPath string, mounts, secretKeyValues []string, privileged, withSSH bool, commandStr string) ([]byte, error) {
+	if c.locally {
+		return c.runLocallyAndReadFile(ctx, args, outputPath, mounts, secretKeyValues, commandStr)
+	}
+	var opts []llb.RunOption
+	mountRunOpts, err := c.parseMounts(ctx, mounts)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse mounts")
+	}
+	opts = append(opts, mountRunOpts...)
+	if privileged {
+		opts = append(opts, llb.Security(llb.SecurityModeInsecure))
+	}
+	opts = append(opts, llb.WithCustomNamef("%s%s", c.vertexPrefix(), commandStr))
+	prevState := c.mts.Final.MainState
+	c.mts.Final.MainState = prevState.File(
+		llb.Mkdir(path.Dir(outputPath), 0777, llb.WithParents(true)),
+		llb.WithCustomNamef("[internal] mkdir %s", path.Dir(outputPath)))
+	err = c.internalRun(ctx, args, secretKeyValues, true, withDefaultShellAndEnvVars, false, withSSH, commandStr, opts...)
+	runState := c.mts.Final.MainState
+	c.mts.Final.MainState = prevState
+	if err != nil {
+		return nil, errors.Wrapf(err, "run %s", commandStr)
+	}
+	ref, err := llbutil.StateToRef(ctx, c.opt.GwClient, runState, c.mts.Final.Platform, c.opt.CacheImports)
+	if err != nil {
+		return nil, errors.Wrapf(err, "state to ref solve %s", commandStr)
+	}
+	dt, err := ref.ReadFile(ctx, gwclient.ReadRequest{
+		Filename: outputPath,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "read output of %s", commandStr)
+	}
+	return dt, nil
+}
+
 func (c *Converter) readArtifact(ctx context.Context, mts *states.MultiTarget, artifact domain.Artifact) ([]byte, error) {
 	if mts.Final.ArtifactsState.Output() == nil {
 		// ArtifactsState is scratch - no artifact has been copied.
@@ -808,6 +1108,23 @@ func (c *Converter) applyFromImage(state llb.State, img *image.Image) (llb.State
 	return state, img, newVarCollection
 }
 
+// refTarget returns the target relative to which other target references are resolved. Within
+// a user-defined command, references are relative to the command's Earthfile.
+func (c *Converter) refTarget() domain.Target {
+	if len(c.commandScopes) > 0 {
+		return c.commandScopes[len(c.commandScopes)-1].command
+	}
+	return c.mts.Final.Target
+}
+
+// shell returns the shell used for the shell form of RUN, CMD and ENTRYPOINT.
+func (c *Converter) shell() []string {
+	if len(c.mts.Final.MainImage.Config.Shell) > 0 {
+		return c.mts.Final.MainImage.Config.Shell
+	}
+	return defaultShell
+}
+
 func (c *Converter) nonSaveCommand() {
 	if c.ranSave {
 		c.mts.Final.HasDangling = true
@@ -825,7 +1142,7 @@ func (c *Converter) processNonConstantBuildArgFunc(ctx context.Context) variable
 		buildArgPath := path.Join("/run/buildargs", name)
 		args := strings.Split(fmt.Sprintf("echo \"%s\" >%s", expression, srcBuildArgPath), " ")
 		err := c.internalRun(
-			ctx, args, []string{}, true, withShellAndEnvVars, false, false, expression,
+			ctx, args, []string{}, true, withDefaultShellAndEnvVars, false, false, expression,
 			llb.WithCustomNamef("%sRUN %s", c.vertexPrefix(), expression))
 		if err != nil {
 			return llb.State{}, dedup.TargetInput{}, 0, errors.Wrapf(err, "run %v", expression)
@@ -921,6 +1238,24 @@ func (c *Converter) setPlatform(platform *specs.Platform) {
 	c.varCollection.SetPlatformArgs(llbutil.PlatformWithDefault(platform))
 }
 
+func isURL(src string) bool {
+	return strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://")
+}
+
+// urlFilename returns the name of the file downloaded from the given URL, using the same
+// rules as the Dockerfile ADD command.
+func urlFilename(src string) (string, error) {
+	u, err := url.Parse(src)
+	if err != nil {
+		return "", errors.Wrapf(err, "parse url %s", src)
+	}
+	filename := path.Base(u.Path)
+	if filename == "/" || filename == "." {
+		filename = "__unnamed__"
+	}
+	return filename, nil
+}
+
 func makeCacheContext(target domain.Target) llb.State {
 	sessionID := cacheKey(target)
 	opts := []llb.LocalOption{
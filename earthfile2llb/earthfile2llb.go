@@ -9,9 +9,11 @@ import (
 	"github.com/earthly/earthly/buildcontext"
 	"github.com/earthly/earthly/buildcontext/provider"
 	"github.com/earthly/earthly/cleanup"
+	"github.com/earthly/earthly/conslogging"
 	"github.com/earthly/earthly/domain"
 	"github.com/earthly/earthly/earthfile2llb/antlrhandler"
 	"github.com/earthly/earthly/earthfile2llb/parser"
+	"github.com/earthly/earthly/features"
 	"github.com/earthly/earthly/llbutil"
 	"github.com/earthly/earthly/states"
 	"github.com/earthly/earthly/variables"
@@ -56,6 +58,14 @@ type ConvertOpt struct {
 	UseInlineCache bool
 	// UseFakeDep is an internal feature flag for fake dep.
 	UseFakeDep bool
+	// Console is the console used for the output of commands executed on the host (LOCALLY).
+	Console conslogging.ConsoleLogger
+	// Features is the set of language features available to the Earthfile being converted. It
+	// is determined by the VERSION declared by the Earthfile and is reset for each Earthfile.
+	Features *features.Features
+	// DryRun converts the Earthfiles without any side effects on the host: the commands of
+	// LOCALLY targets and the copies of artifacts onto the host are recorded, not executed.
+	DryRun bool
 }
 
 // Earthfile2LLB parses a earthfile and executes the statements for a given target.
@@ -111,39 +121,35 @@ func Earthfile2LLB(ctx context.Context, target domain.Target, opt ConvertOpt) (m
 		return nil, errors.Wrapf(err, "resolve build context for target %s", target.String())
 	}
 	// Convert.
-	errorListener := antlrhandler.NewReturnErrorListener()
-	errorStrategy := antlrhandler.NewReturnErrorStrategy()
-	tree, err := newEarthfileTree(bc.BuildFilePath, errorListener, errorStrategy)
+	opt.Features = features.Default()
+	converter, err := NewConverter(ctx, bc.Target, bc, opt)
 	if err != nil {
 		return nil, err
 	}
-	converter, err := NewConverter(ctx, bc.Target, bc, opt)
+	err = walkEarthfile(bc.BuildFilePath, newListener(ctx, converter, target.Target))
 	if err != nil {
 		return nil, err
 	}
-	walkErr := walkTree(newListener(ctx, converter, target.Target), tree)
-	if len(errorListener.Errs) > 0 {
-		var errString []string
-		for _, err := range errorListener.Errs {
-			errString = append(errString, err.Error())
-		}
-		return nil, fmt.Errorf(strings.Join(errString, "\n"))
+	return converter.FinalizeStates(ctx)
+}
+
+// walkEarthfile parses the build file at the given path and walks it using the provided listener.
+func walkEarthfile(filename string, l *listener) error {
+	errorListener := antlrhandler.NewReturnErrorListener()
+	errorStrategy := antlrhandler.NewReturnErrorStrategy()
+	tree, err := newEarthfileTree(filename, errorListener, errorStrategy)
+	if err != nil {
+		return err
 	}
-	if errorStrategy.Err != nil {
-		var errString []string
-		errString = append(errString,
-			fmt.Sprintf(
-				"syntax error: line %d:%d",
-				errorStrategy.RE.GetOffendingToken().GetLine(),
-				errorStrategy.RE.GetOffendingToken().GetColumn()))
-		errString = append(errString,
-			fmt.Sprintf("Details: %s", errorStrategy.RE.GetMessage()))
-		return nil, errors.Wrapf(errorStrategy.Err, "%s", strings.Join(errString, "\n"))
+	walkErr := walkTree(l, tree)
+	err = syntaxError(filename, errorListener, errorStrategy)
+	if err != nil {
+		return err
 	}
-	if walkErr != nil {
-		return nil, walkErr
+	if l.err != nil {
+		return stmtError(filename, l.stmtStart, l.err)
 	}
-	return converter.FinalizeStates(ctx)
+	return walkErr
 }
 
 func walkTree(l *listener, tree parser.IEarthFileContext) (err error) {
@@ -177,13 +183,23 @@ func newEarthfileTree(filename string, errorListener antlr.ErrorListener, errorS
 	if err != nil {
 		return nil, errors.Wrapf(err, "new file stream %s", filename)
 	}
+	return newEarthfileTreeFromStream(input, nil, errorListener, errorStrategy), nil
+}
+
+// newEarthfileTreeFromStream parses an Earthfile. If lexerErrorListener is set, it replaces the
+// default error listener of the lexer, which prints to the console.
+func newEarthfileTreeFromStream(input antlr.CharStream, lexerErrorListener, errorListener antlr.ErrorListener, errorStrategy antlr.ErrorStrategy) parser.IEarthFileContext {
 	lexer := newLexer(input)
+	if lexerErrorListener != nil {
+		lexer.RemoveErrorListeners()
+		lexer.AddErrorListener(lexerErrorListener)
+	}
 	stream := antlr.NewCommonTokenStream(lexer, 0)
 	p := parser.NewEarthParser(stream)
 	p.AddErrorListener(errorListener)
 	p.SetErrorHandler(errorStrategy)
 	p.BuildParseTrees = true
-	return p.EarthFile(), nil
+	return p.EarthFile()
 }
 
 // GetTargets returns a list of targets from an Earthfile
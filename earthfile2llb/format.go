@@ -0,0 +1,585 @@
+package earthfile2llb
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/earthly/earthly/earthfile2llb/antlrhandler"
+	"github.com/earthly/earthly/earthfile2llb/parser"
+)
+
+const (
+	// formatIndent is the indentation of recipes and of the blocks nested within them.
+	formatIndent = "    "
+	// formatMaxWidth is the line width beyond which the args of a command are wrapped onto
+	// continuation lines.
+	formatMaxWidth = 100
+)
+
+// Format parses the Earthfile at the given path and returns its contents in canonical form:
+// canonical indentation, single spaces between args (outside of quoted strings), flags in the
+// order in which the command declares them, continuation lines for long commands and at most
+// one blank line between statements. Comments are preserved.
+func Format(filename string) ([]byte, error) {
+	errorListener := antlrhandler.NewReturnErrorListener()
+	errorStrategy := antlrhandler.NewReturnErrorStrategy()
+	tree, err := newEarthfileTree(filename, errorListener, errorStrategy)
+	if err != nil {
+		return nil, err
+	}
+	err = syntaxError(filename, errorListener, errorStrategy)
+	if err != nil {
+		return nil, err
+	}
+	fl := new(formatListener)
+	antlr.ParseTreeWalkerDefault.Walk(fl, tree)
+	return []byte(fl.render()), nil
+}
+
+type formatItemKind int
+
+const (
+	formatBlank formatItemKind = iota
+	formatComment
+	formatTarget
+	formatStmt
+)
+
+// formatItem is a line (or, for statements, a group of continuation lines) of the output.
+type formatItem struct {
+	kind  formatItemKind
+	depth int
+	// text is the comment, or the target header.
+	text string
+	// indented is set for comments which were indented in the original Earthfile.
+	indented bool
+	// comment is the trailing comment of a target header or of a statement.
+	comment string
+
+	command string
+	words   []formatWord
+	// verbatim is set for statements whose args are not subject to flag reordering.
+	verbatim bool
+}
+
+type formatWord struct {
+	text string
+	// breakBefore is set if the word was preceded by a line continuation.
+	breakBefore bool
+	// extraIndent is the indentation of the continuation line starting with the word, beyond
+	// the canonical one. It preserves the nesting of continuation lines.
+	extraIndent int
+	// attached is set if the word is kept on the same line as the previous word, such as the
+	// value of a flag.
+	attached bool
+	// space is the whitespace which preceded the word on the same line. It is kept within
+	// quoted strings.
+	space string
+}
+
+// formatListener collects the items of an Earthfile to be formatted.
+type formatListener struct {
+	*parser.BaseEarthParserListener
+	items      []*formatItem
+	inRecipe   bool
+	blockDepth int
+	// lineItem is the item whose line is terminated by the next NL token, if any.
+	lineItem *formatItem
+}
+
+func (l *formatListener) VisitTerminal(node antlr.TerminalNode) {
+	if node.GetSymbol().GetTokenType() == parser.EarthLexerEND {
+		l.blockDepth--
+		l.addItem(&formatItem{kind: formatStmt, command: node.GetText()}, nil, l.blockDepth)
+		return
+	}
+	if node.GetSymbol().GetTokenType() != parser.EarthLexerNL {
+		return
+	}
+	text := node.GetText()
+	comment := lineComment(text)
+	if l.lineItem != nil {
+		l.lineItem.comment = comment
+		l.lineItem = nil
+		return
+	}
+	if comment == "" {
+		l.items = append(l.items, &formatItem{kind: formatBlank})
+		return
+	}
+	l.items = append(l.items, &formatItem{
+		kind:     formatComment,
+		text:     comment,
+		indented: strings.HasPrefix(text, " ") || strings.HasPrefix(text, "\t"),
+	})
+}
+
+func (l *formatListener) EnterTargetHeader(c *parser.TargetHeaderContext) {
+	item := &formatItem{
+		kind: formatTarget,
+		text: c.GetText(),
+	}
+	l.items = append(l.items, item)
+	l.lineItem = item
+	l.inRecipe = true
+	l.blockDepth = 0
+}
+
+func (l *formatListener) EnterStmt(c *parser.StmtContext) {
+	if c.WithDockerStmt() != nil || c.IfStmt() != nil || c.ForStmt() != nil {
+		// Formatted clause by clause.
+		return
+	}
+	item := &formatItem{kind: formatStmt}
+	var hoisted []string
+	switch {
+	case c.EnvStmt() != nil:
+		ec := c.EnvStmt().(*parser.EnvStmtContext)
+		item.command = ec.ENV().GetText()
+		item.words = keyValueWords(ec.EnvArgKey(), ec.EQUALS(), ec.EnvArgValue())
+		item.verbatim = true
+	case c.ArgStmt() != nil:
+		ac := c.ArgStmt().(*parser.ArgStmtContext)
+		item.command = ac.ARG().GetText()
+		item.words = keyValueWords(ac.EnvArgKey(), ac.EQUALS(), ac.EnvArgValue())
+		item.verbatim = true
+	case c.LabelStmt() != nil:
+		lc := c.LabelStmt().(*parser.LabelStmtContext)
+		item.command = lc.LABEL().GetText()
+		keys := lc.AllLabelKey()
+		values := lc.AllLabelValue()
+		for i := range keys {
+			item.words = append(item.words, formatWord{text: keys[i].GetText() + "=" + values[i].GetText()})
+		}
+		item.verbatim = true
+	default:
+		item, hoisted = commandItem(terminalNodes(c))
+	}
+	l.addItem(item, hoisted, l.blockDepth)
+}
+
+func (l *formatListener) ExitStmt(c *parser.StmtContext) {
+	l.lineItem = l.items[len(l.items)-1]
+}
+
+func (l *formatListener) EnterWithDockerClause(c *parser.WithDockerClauseContext) {
+	l.enterClause(c, l.blockDepth)
+}
+
+func (l *formatListener) EnterIfClause(c *parser.IfClauseContext) {
+	l.enterClause(c, l.blockDepth)
+}
+
+func (l *formatListener) EnterElseIfClause(c *parser.ElseIfClauseContext) {
+	l.enterClause(c, l.blockDepth-1)
+}
+
+func (l *formatListener) EnterElseClause(c *parser.ElseClauseContext) {
+	l.enterClause(c, l.blockDepth-1)
+}
+
+func (l *formatListener) EnterForClause(c *parser.ForClauseContext) {
+	l.enterClause(c, l.blockDepth)
+}
+
+// enterClause adds the line introducing a block, such as IF or ELSE, at the given depth. The
+// statements of the block are indented one level further, up to the matching END.
+func (l *formatListener) enterClause(c antlr.Tree, depth int) {
+	item, hoisted := commandItem(clauseNodes(c))
+	l.addItem(item, hoisted, depth)
+	l.lineItem = item
+	l.blockDepth = depth + 1
+}
+
+// addItem adds a statement at the given block depth.
+func (l *formatListener) addItem(item *formatItem, hoisted []string, depth int) {
+	if depth < 0 {
+		depth = 0
+	}
+	if l.inRecipe {
+		depth++
+	}
+	item.depth = depth
+	// Comments within line continuations are moved before the statement.
+	for _, comment := range hoisted {
+		l.items = append(l.items, &formatItem{kind: formatComment, text: comment, indented: item.depth > 0})
+	}
+	l.items = append(l.items, item)
+}
+
+// commandItem returns the item of a command and its args, together with the comments within
+// its line continuations.
+func commandItem(nodes []antlr.TerminalNode) (*formatItem, []string) {
+	item := &formatItem{kind: formatStmt}
+	var hoisted []string
+	breakBefore := false
+	commandColumn := 0
+	extraIndent := 0
+	space := ""
+	for _, node := range nodes {
+		text := node.GetText()
+		switch node.GetSymbol().GetTokenType() {
+		case parser.EarthLexerAtom:
+			item.words = append(item.words, formatWord{
+				text:        text,
+				breakBefore: breakBefore,
+				extraIndent: extraIndent,
+				space:       space,
+			})
+			breakBefore = false
+			extraIndent = 0
+			space = ""
+		case parser.EarthLexerWS:
+			if !strings.Contains(text, "\n") && strings.Contains(text, "\\") {
+				// A backslash which is not followed by a new line is kept as is.
+				item.words = append(item.words, formatWord{text: strings.TrimSpace(text)})
+				space = ""
+				continue
+			}
+			space = text
+			if strings.Contains(text, "\\") {
+				breakBefore = true
+				hoisted = append(hoisted, continuationComments(text)...)
+				lineIndent := len(text) - strings.LastIndex(text, "\n") - 1
+				extraIndent = lineIndent - commandColumn - len(formatIndent)
+				if extraIndent < 0 {
+					extraIndent = 0
+				}
+			}
+		default:
+			if item.command == "" {
+				item.command = text
+				commandColumn = node.GetSymbol().GetColumn()
+			}
+		}
+	}
+	return item, hoisted
+}
+
+// render returns the formatted Earthfile.
+func (l *formatListener) render() string {
+	items := normalizeBlankLines(l.items)
+	var sb strings.Builder
+	for i, item := range items {
+		switch item.kind {
+		case formatBlank:
+			sb.WriteString("\n")
+		case formatComment:
+			sb.WriteString(strings.Repeat(formatIndent, commentDepth(items, i)))
+			sb.WriteString(item.text)
+			sb.WriteString("\n")
+		case formatTarget:
+			sb.WriteString(item.text)
+			if item.comment != "" {
+				sb.WriteString(" " + item.comment)
+			}
+			sb.WriteString("\n")
+		case formatStmt:
+			sb.WriteString(renderStmt(item))
+		}
+	}
+	return sb.String()
+}
+
+func renderStmt(item *formatItem) string {
+	words := item.words
+	if !item.verbatim {
+		words = canonicalFlags(item.command, words)
+	}
+	indent := strings.Repeat(formatIndent, item.depth)
+	contIndent := indent + formatIndent
+	// Statements which are already split into continuation lines keep their layout. Otherwise,
+	// long statements are wrapped.
+	wrap := true
+	for _, word := range words {
+		if word.breakBefore || (strings.Contains(word.text, "\n") && !isHeredoc(word.text)) {
+			wrap = false
+			break
+		}
+	}
+	lines := []string{indent + item.command}
+	var heredocs []heredoc
+	var quote rune
+	for i, word := range words {
+		// Words within a quoted string are not wrapped.
+		inQuote := quote != 0
+		quote = quoteAfter(word.text, quote)
+		text := word.text
+		h, ok := parseHeredoc(text)
+		if ok {
+			heredocs = append(heredocs, h)
+			text = h.header
+		}
+		cur := lines[len(lines)-1]
+		switch {
+		case word.breakBefore:
+			lines = append(lines, contIndent+strings.Repeat(" ", word.extraIndent)+text)
+		case i > 0 && wrap && !word.attached && !inQuote && len(cur)+1+len(text) > formatMaxWidth:
+			lines = append(lines, contIndent+text)
+		case inQuote && word.space != "":
+			// The whitespace within a quoted string is part of its value.
+			lines[len(lines)-1] = cur + word.space + text
+		default:
+			lines[len(lines)-1] = cur + " " + text
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString(strings.Join(lines, " \\\n"))
+	if item.comment != "" {
+		sb.WriteString(" " + item.comment)
+	}
+	sb.WriteString("\n")
+	for _, h := range heredocs {
+		// The lexer removes the indentation of the delimiter from the body.
+		for _, line := range strings.SplitAfter(h.body, "\n") {
+			if strings.TrimSpace(line) != "" {
+				sb.WriteString(indent)
+			}
+			sb.WriteString(line)
+		}
+		sb.WriteString(indent + h.delimiter + "\n")
+	}
+	return sb.String()
+}
+
+// quoteAfter returns the quote character which is open after the text, given the quote
+// character open before it (0 if none).
+func quoteAfter(text string, quote rune) rune {
+	escaped := false
+	for _, r := range text {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+		case quote == 0 && (r == '"' || r == '\''):
+			quote = r
+		case r == quote:
+			quote = 0
+		}
+	}
+	return quote
+}
+
+// normalizeBlankLines removes leading, trailing and repeated blank lines, as well as blank lines
+// following a target header, and separates targets by a blank line. Comments directly preceding
+// a target header stay attached to it.
+func normalizeBlankLines(items []*formatItem) []*formatItem {
+	var ret []*formatItem
+	for _, item := range items {
+		if item.kind == formatBlank {
+			if len(ret) == 0 {
+				continue
+			}
+			prev := ret[len(ret)-1]
+			if prev.kind == formatBlank || prev.kind == formatTarget {
+				continue
+			}
+		}
+		if item.kind == formatTarget {
+			start := len(ret)
+			for start > 0 && ret[start-1].kind == formatComment && !ret[start-1].indented {
+				start--
+			}
+			if start > 0 && ret[start-1].kind != formatBlank {
+				ret = append(ret[:start], append([]*formatItem{{kind: formatBlank}}, ret[start:]...)...)
+			}
+		}
+		ret = append(ret, item)
+	}
+	for len(ret) > 0 && ret[len(ret)-1].kind == formatBlank {
+		ret = ret[:len(ret)-1]
+	}
+	return ret
+}
+
+// commentDepth returns the indentation level of the comment at index i: that of the statement
+// it precedes or, if it is at the end of a recipe, that of the statement before it.
+func commentDepth(items []*formatItem, i int) int {
+	for _, item := range items[i+1:] {
+		if item.kind == formatStmt {
+			return item.depth
+		}
+		if item.kind != formatComment {
+			break
+		}
+	}
+	if !items[i].indented {
+		return 0
+	}
+	for j := i - 1; j >= 0; j-- {
+		switch items[j].kind {
+		case formatStmt:
+			return items[j].depth
+		case formatTarget:
+			return 1
+		}
+	}
+	return 0
+}
+
+// formatFlag is a flag of a command. The flags of a command are listed in canonical order, which
+// is the order in which the command declares them.
+type formatFlag struct {
+	name     string
+	hasValue bool
+}
+
+var formatFlags = map[string][]formatFlag{
+	"FROM":            {{"build-arg", true}, {"platform", true}},
+	"FROM DOCKERFILE": {{"build-arg", true}, {"platform", true}, {"target", true}, {"f", true}},
+	"COPY": {
+		{"from", true}, {"dir", false}, {"chown", true}, {"keep-ts", false}, {"keep-own", false},
+		{"if-exists", false}, {"platform", true}, {"build-arg", true},
+	},
+	"RUN": {
+		{"push", false}, {"privileged", false}, {"entrypoint", false}, {"with-docker", false},
+		{"ssh", false}, {"secret", true}, {"mount", true},
+	},
+	"SAVE ARTIFACT": {{"keep-ts", false}, {"keep-own", false}, {"if-exists", false}},
+	"SAVE IMAGE":    {{"push", false}, {"cache-hint", false}, {"insecure", false}, {"cache-from", true}},
+	"BUILD":         {{"platform", true}, {"build-arg", true}},
+	"GIT CLONE":     {{"branch", true}, {"keep-ts", false}},
+	"HEALTHCHECK":   {{"interval", true}, {"timeout", true}, {"start-period", true}, {"retries", true}},
+	"WITH DOCKER": {
+		{"compose", true}, {"service", true}, {"load", true}, {"platform", true}, {"build-arg", true},
+		{"pull", true},
+	},
+	"ADD":     {{"chown", true}, {"keep-ts", false}, {"checksum", true}},
+	"IF":      {{"privileged", false}, {"ssh", false}, {"secret", true}, {"mount", true}},
+	"ELSE IF": {{"privileged", false}, {"ssh", false}, {"secret", true}, {"mount", true}},
+	"FOR":     {{"sep", true}, {"privileged", false}, {"ssh", false}, {"secret", true}, {"mount", true}},
+}
+
+// canonicalFlags sorts the flags of the command in canonical order and spells them with two
+// dashes. Repeated flags keep their relative order. The words are returned unchanged if they
+// contain any unknown flag.
+func canonicalFlags(command string, words []formatWord) []formatWord {
+	spec, ok := formatFlags[command]
+	if !ok {
+		return words
+	}
+	type flagWords struct {
+		order int
+		words []formatWord
+	}
+	var flags []flagWords
+	i := 0
+	for ; i < len(words); i++ {
+		text := words[i].text
+		if text == "--" || text == "-" || !strings.HasPrefix(text, "-") {
+			break
+		}
+		name := strings.TrimPrefix(strings.TrimPrefix(text, "-"), "-")
+		if strings.HasPrefix(name, "-") {
+			return words
+		}
+		eq := strings.Index(name, "=")
+		if eq >= 0 {
+			name = name[:eq]
+		}
+		order := -1
+		for j, f := range spec {
+			if f.name == name {
+				order = j
+				break
+			}
+		}
+		if order == -1 {
+			return words
+		}
+		first := words[i]
+		first.text = "--" + strings.TrimLeft(text, "-")
+		fw := flagWords{order: order, words: []formatWord{first}}
+		if spec[order].hasValue && eq == -1 {
+			if i+1 >= len(words) {
+				return words
+			}
+			i++
+			value := words[i]
+			// Keep the flag and its value on the same line.
+			value.breakBefore = false
+			value.attached = true
+			fw.words = append(fw.words, value)
+		}
+		flags = append(flags, fw)
+	}
+	// The line breaks stay in place, while the flags move.
+	breaks := make([]formatWord, len(flags))
+	for k, f := range flags {
+		breaks[k] = f.words[0]
+	}
+	sort.SliceStable(flags, func(a, b int) bool {
+		return flags[a].order < flags[b].order
+	})
+	ret := make([]formatWord, 0, len(words))
+	for k, f := range flags {
+		f.words[0].breakBefore = breaks[k].breakBefore
+		f.words[0].extraIndent = breaks[k].extraIndent
+		ret = append(ret, f.words...)
+	}
+	return append(ret, words[i:]...)
+}
+
+func keyValueWords(key antlr.Tree, equals antlr.TerminalNode, value antlr.Tree) []formatWord {
+	keyText := key.(antlr.ParseTree).GetText()
+	valueText := ""
+	if v, ok := value.(antlr.ParseTree); ok {
+		valueText = v.GetText()
+	}
+	if equals != nil {
+		return []formatWord{{text: keyText + "=" + valueText}}
+	}
+	if valueText == "" {
+		return []formatWord{{text: keyText}}
+	}
+	return []formatWord{{text: keyText}, {text: valueText}}
+}
+
+// clauseNodes returns the terminal nodes of the line introducing a clause, without the block
+// which follows it.
+func clauseNodes(c antlr.Tree) []antlr.TerminalNode {
+	var ret []antlr.TerminalNode
+	for _, child := range c.GetChildren() {
+		tn, ok := child.(antlr.TerminalNode)
+		if ok && tn.GetSymbol().GetTokenType() == parser.EarthLexerNL {
+			break
+		}
+		ret = append(ret, terminalNodes(child)...)
+	}
+	return ret
+}
+
+// terminalNodes returns the terminal nodes of the tree, in order.
+func terminalNodes(tree antlr.Tree) []antlr.TerminalNode {
+	if tn, ok := tree.(antlr.TerminalNode); ok {
+		return []antlr.TerminalNode{tn}
+	}
+	var ret []antlr.TerminalNode
+	for _, child := range tree.GetChildren() {
+		ret = append(ret, terminalNodes(child)...)
+	}
+	return ret
+}
+
+// lineComment returns the comment contained in the text of an NL token, if any.
+func lineComment(nl string) string {
+	idx := strings.Index(nl, "#")
+	if idx == -1 {
+		return ""
+	}
+	return strings.TrimRight(nl[idx:], " \t\r\n")
+}
+
+// continuationComments returns the comments within the line continuations of a WS token.
+func continuationComments(ws string) []string {
+	var ret []string
+	for _, line := range strings.Split(ws, "\n") {
+		comment := lineComment(line)
+		if comment != "" {
+			ret = append(ret, comment)
+		}
+	}
+	return ret
+}
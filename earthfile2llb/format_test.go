@@ -0,0 +1,85 @@
+package earthfile2llb
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	var tests = []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			"indentation",
+			"FROM alpine:3.11\nbuild:\n  RUN  echo   hi\n\n\n  SAVE ARTIFACT out\n",
+			"FROM alpine:3.11\n\nbuild:\n    RUN echo hi\n\n    SAVE ARTIFACT out\n",
+		},
+		{
+			"blocks",
+			"build:\n    IF [ -f a ]\n    RUN echo a\n  ELSE IF --privileged [ -f b ]\n    WITH DOCKER\n    RUN echo b\n    END\n    ELSE\n    FOR x IN a b\n    RUN echo $x\n    END\n    END\n",
+			"build:\n    IF [ -f a ]\n        RUN echo a\n    ELSE IF --privileged [ -f b ]\n        WITH DOCKER\n            RUN echo b\n        END\n    ELSE\n        FOR x IN a b\n            RUN echo $x\n        END\n    END\n",
+		},
+		{
+			"comments",
+			"# header\nFROM alpine:3.11 # base\n\n# builds it\nbuild: # target\n    # step\n    RUN echo hi # trailing\n",
+			"# header\nFROM alpine:3.11 # base\n\n# builds it\nbuild: # target\n    # step\n    RUN echo hi # trailing\n",
+		},
+		{
+			"flag order",
+			"build:\n    COPY --dir --from=+src --chown root a b\n    RUN --mount=type=cache,target=/c --privileged -- ls\n",
+			"build:\n    COPY --from=+src --dir --chown root a b\n    RUN --privileged --mount=type=cache,target=/c -- ls\n",
+		},
+		{
+			"continuation",
+			"build:\n    RUN echo a && \\\n      echo b\n    BUILD \\\n    --build-arg A=1 \\\n    +target\n",
+			"build:\n    RUN echo a && \\\n        echo b\n    BUILD \\\n        --build-arg A=1 \\\n        +target\n",
+		},
+		{
+			"wrap",
+			"build:\n    RUN echo " + strings.Repeat("a", 50) + " " + strings.Repeat("b", 50) + " \"c d\"\n",
+			"build:\n    RUN echo " + strings.Repeat("a", 50) + " \\\n        " + strings.Repeat("b", 50) + " \"c d\"\n",
+		},
+		{
+			"whitespace within quotes",
+			"build:\n    RUN  echo   'hello     world'  \"a \t\t b\"   c\n",
+			"build:\n    RUN echo 'hello     world' \"a \t\t b\" c\n",
+		},
+		{
+			"heredoc",
+			"build:\n  RUN cat <<EOF >/a.txt\nline\n  EOF\n",
+			"build:\n    RUN cat <<EOF >/a.txt\n    line\n    EOF\n",
+		},
+	}
+	for _, tt := range tests {
+		actual, err := formatString(t, tt.input)
+		NoError(t, err, tt.name)
+		Equal(t, tt.expected, actual, tt.name)
+
+		// Formatting is idempotent.
+		again, err := formatString(t, actual)
+		NoError(t, err, tt.name)
+		Equal(t, actual, again, tt.name)
+	}
+}
+
+func TestFormatSyntaxError(t *testing.T) {
+	_, err := formatString(t, "build:\nother:\n    RUN echo hi\n")
+	Error(t, err)
+}
+
+func formatString(t *testing.T, input string) (string, error) {
+	f, err := ioutil.TempFile("", "Earthfile")
+	NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(input)
+	NoError(t, err)
+	NoError(t, f.Close())
+	out, err := Format(f.Name())
+	return string(out), err
+}
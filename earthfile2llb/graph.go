@@ -0,0 +1,305 @@
+package earthfile2llb
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/earthly/earthly/domain"
+	"github.com/pkg/errors"
+)
+
+// The types of the edges of a Graph.
+const (
+	// GraphEdgeFrom is a FROM of a target.
+	GraphEdgeFrom = "FROM"
+	// GraphEdgeCopy is a COPY of an artifact of a target.
+	GraphEdgeCopy = "COPY"
+	// GraphEdgeBuild is a BUILD of a target.
+	GraphEdgeBuild = "BUILD"
+	// GraphEdgeDo is a DO of a user-defined command.
+	GraphEdgeDo = "DO"
+	// GraphEdgeLoad is a WITH DOCKER --load of the image of a target.
+	GraphEdgeLoad = "LOAD"
+)
+
+// Graph is the dependency graph of a set of targets.
+type Graph struct {
+	// Targets are the nodes of the graph, as target refs relative to the current directory.
+	Targets []string    `json:"targets"`
+	Edges   []GraphEdge `json:"edges"`
+	// Unresolved are the targets whose refs are only known at build time, such as refs
+	// containing variables or unknown import aliases.
+	Unresolved []string `json:"unresolved"`
+}
+
+// GraphEdge is a reference from a target to another.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// BuildGraph returns the dependency graph of the given local targets, following the references
+// to other local targets. The Earthfiles are only parsed, not built: remote targets are not
+// followed, references containing variables are not expanded and the references within all
+// the branches of IF and FOR blocks are included.
+func BuildGraph(targets []domain.Target) (*Graph, error) {
+	gb := &graphBuilder{
+		graph: &Graph{
+			Targets:    []string{},
+			Edges:      []GraphEdge{},
+			Unresolved: []string{},
+		},
+		files:   make(map[string]*lintListener),
+		imports: make(map[string]*domain.ImportTracker),
+		nodes:   make(map[string]bool),
+		edges:   make(map[GraphEdge]bool),
+	}
+	for _, target := range targets {
+		if !target.IsLocalInternal() && !target.IsLocalExternal() {
+			return nil, fmt.Errorf("%s is not a local target", target.String())
+		}
+		found, err := gb.visit(target)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, fmt.Errorf("target %s not defined", target.String())
+		}
+	}
+	return gb.graph, nil
+}
+
+// AllTargets returns the targets of the Earthfile in the given directory.
+func AllTargets(dir string) ([]domain.Target, error) {
+	filename, ok := buildFileIn(dir)
+	if !ok {
+		return nil, fmt.Errorf("no Earthfile nor build.earth file found in %s", dir)
+	}
+	names, err := GetTargets(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get targets of %s", filename)
+	}
+	localPath := filepath.ToSlash(filepath.Clean(dir))
+	if localPath != "." && !filepath.IsAbs(dir) && !strings.HasPrefix(localPath, ".") {
+		localPath = "./" + localPath
+	}
+	var ret []domain.Target
+	for _, name := range names {
+		ret = append(ret, domain.Target{LocalPath: localPath, Target: name})
+	}
+	return ret, nil
+}
+
+type graphBuilder struct {
+	graph *Graph
+	// files are the Earthfiles parsed so far, by path.
+	files map[string]*lintListener
+	// imports are the imports declared by the Earthfiles parsed so far, by path.
+	imports map[string]*domain.ImportTracker
+	nodes   map[string]bool
+	edges   map[GraphEdge]bool
+}
+
+// visit adds the target and its dependencies to the graph. It returns false if the target is
+// not defined.
+func (gb *graphBuilder) visit(target domain.Target) (bool, error) {
+	name := target.String()
+	if gb.nodes[name] {
+		return true, nil
+	}
+	filename, ok := buildFileIn(filepath.FromSlash(target.LocalPath))
+	if !ok {
+		return false, nil
+	}
+	ll, ok := gb.files[filename]
+	if !ok {
+		var err error
+		ll, err = collectStmts(filename)
+		if err != nil {
+			return false, err
+		}
+		gb.files[filename] = ll
+		gb.imports[filename] = fileImports(ll)
+	}
+	imports := gb.imports[filename]
+	stmtTarget := target.Target
+	if stmtTarget == "base" {
+		stmtTarget = ""
+	}
+	if stmtTarget != "" && !stringIn(stmtTarget, ll.targets) {
+		return false, nil
+	}
+	gb.nodes[name] = true
+	gb.graph.Targets = append(gb.graph.Targets, name)
+
+	if stmtTarget != "" && inheritsBase(ll, stmtTarget) && recipeHasRefs(ll, "") {
+		base := target
+		base.Target = "base"
+		err := gb.addEdge(name, base, GraphEdgeFrom)
+		if err != nil {
+			return false, err
+		}
+	}
+	for _, stmt := range ll.stmts {
+		if stmt.target != stmtTarget {
+			continue
+		}
+		for _, ref := range stmt.targetRefs() {
+			if strings.Contains(ref.ref, "$") {
+				// Not known until build time.
+				gb.addUnresolved(name, ref)
+				continue
+			}
+			refTarget, err := domain.ParseTarget(ref.ref)
+			if err != nil {
+				continue
+			}
+			refTarget, err = imports.Deref(refTarget)
+			if err != nil {
+				// Unknown import; kept in the graph as is.
+				gb.addUnresolved(name, ref)
+				continue
+			}
+			refTarget, err = domain.JoinTargets(target, refTarget)
+			if err != nil {
+				return false, errors.Wrapf(err, "join targets %s and %s", name, ref.ref)
+			}
+			err = gb.addEdge(name, refTarget, ref.kind)
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// addEdge adds an edge to the given target, visiting it if it is local.
+func (gb *graphBuilder) addEdge(from string, to domain.Target, kind string) error {
+	if to.IsLocalInternal() || to.IsLocalExternal() {
+		found, err := gb.visit(to)
+		if err != nil {
+			return err
+		}
+		if !found {
+			// Reported by earthly lint; kept in the graph as is.
+			gb.addNode(to.String())
+		}
+	} else {
+		gb.addNode(to.String())
+	}
+	gb.addEdgeName(from, to.String(), kind)
+	return nil
+}
+
+// addUnresolved adds an edge to a ref which cannot be resolved without building.
+func (gb *graphBuilder) addUnresolved(from string, ref stmtRef) {
+	if !gb.nodes[ref.ref] {
+		gb.graph.Unresolved = append(gb.graph.Unresolved, ref.ref)
+	}
+	gb.addNode(ref.ref)
+	gb.addEdgeName(from, ref.ref, ref.kind)
+}
+
+func (gb *graphBuilder) addNode(name string) {
+	if gb.nodes[name] {
+		return
+	}
+	gb.nodes[name] = true
+	gb.graph.Targets = append(gb.graph.Targets, name)
+}
+
+func (gb *graphBuilder) addEdgeName(from, to, kind string) {
+	edge := GraphEdge{From: from, To: to, Type: kind}
+	if gb.edges[edge] {
+		return
+	}
+	gb.edges[edge] = true
+	gb.graph.Edges = append(gb.graph.Edges, edge)
+}
+
+// fileImports returns the imports declared by an Earthfile. Invalid imports are ignored; they
+// are reported when building.
+func fileImports(ll *lintListener) *domain.ImportTracker {
+	it := domain.NewImportTracker()
+	for _, stmt := range ll.stmts {
+		if stmt.target != "" || stmt.command != "IMPORT" {
+			continue
+		}
+		switch {
+		case len(stmt.words) == 1:
+			_ = it.Add(stmt.words[0], "")
+		case len(stmt.words) == 3 && stmt.words[1] == "AS":
+			_ = it.Add(stmt.words[0], stmt.words[2])
+		}
+	}
+	return it
+}
+
+// inheritsBase returns whether the target starts from the base recipe, as opposed to
+// replacing it via FROM.
+func inheritsBase(ll *lintListener, target string) bool {
+	for _, stmt := range ll.stmts {
+		if stmt.target == target {
+			return stmt.command != "FROM" && stmt.command != "FROM DOCKERFILE" && stmt.command != "LOCALLY"
+		}
+	}
+	return true
+}
+
+// recipeHasRefs returns whether the recipe of the target references other targets.
+func recipeHasRefs(ll *lintListener, target string) bool {
+	for _, stmt := range ll.stmts {
+		if stmt.target == target && len(stmt.targetRefs()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// isUnresolved returns whether the target is one of the unresolved targets of the graph.
+func (g *Graph) isUnresolved(target string) bool {
+	return stringIn(target, g.Unresolved)
+}
+
+// DOT returns the graph in the Graphviz DOT language. Unresolved targets are dashed.
+func (g *Graph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph earthly {\n")
+	for _, target := range g.Targets {
+		if g.isUnresolved(target) {
+			fmt.Fprintf(&sb, "  %s [style=dashed];\n", strconv.Quote(target))
+			continue
+		}
+		fmt.Fprintf(&sb, "  %s;\n", strconv.Quote(target))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s -> %s [label=%s];\n",
+			strconv.Quote(edge.From), strconv.Quote(edge.To), strconv.Quote(edge.Type))
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid returns the graph as a Mermaid flowchart. Unresolved targets are dashed.
+func (g *Graph) Mermaid() string {
+	ids := make(map[string]string)
+	var sb strings.Builder
+	sb.WriteString("graph LR\n")
+	for i, target := range g.Targets {
+		ids[target] = fmt.Sprintf("n%d", i)
+		fmt.Fprintf(&sb, "  %s[\"%s\"]\n", ids[target], strings.Replace(target, "\"", "#quot;", -1))
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&sb, "  %s -->|%s| %s\n", ids[edge.From], edge.Type, ids[edge.To])
+	}
+	if len(g.Unresolved) > 0 {
+		sb.WriteString("  classDef unresolved stroke-dasharray: 5 5\n")
+		for _, target := range g.Unresolved {
+			fmt.Fprintf(&sb, "  class %s unresolved\n", ids[target])
+		}
+	}
+	return sb.String()
+}
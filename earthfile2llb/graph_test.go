@@ -0,0 +1,127 @@
+package earthfile2llb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/earthly/earthly/domain"
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestBuildGraph(t *testing.T) {
+	dir, err := ioutil.TempDir("", "earthly-graph")
+	NoError(t, err)
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"Earthfile": `FROM alpine:3.11
+
+deps:
+    COPY ./sub+lib/out ./
+
+build:
+    FROM +deps
+    COPY +deps/out ./
+    COPY +deps/out2 ./
+    BUILD github.com/earthly/hello-world+hello
+    WITH DOCKER --load img=./sub+image
+        RUN docker run img
+    END
+    BUILD +$TARGET
+
+unrelated:
+    FROM alpine:3.11
+`,
+		"sub/Earthfile": `FROM +base-image
+
+lib:
+    DO ../+cmd
+    SAVE ARTIFACT out
+
+image:
+    SAVE IMAGE img
+
+base-image:
+    FROM alpine:3.11
+`,
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+	}
+	wd, err := os.Getwd()
+	NoError(t, err)
+	NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	graph, err := BuildGraph([]domain.Target{{LocalPath: ".", Target: "build"}})
+	NoError(t, err)
+	Equal(t, []string{
+		"+build", "+deps", "./sub+lib", "./sub+base", "./sub+base-image", "+cmd",
+		"github.com/earthly/hello-world+hello", "./sub+image", "+$TARGET",
+	}, graph.Targets)
+	Equal(t, []GraphEdge{
+		{From: "./sub+base", To: "./sub+base-image", Type: GraphEdgeFrom},
+		{From: "./sub+lib", To: "./sub+base", Type: GraphEdgeFrom},
+		{From: "./sub+lib", To: "+cmd", Type: GraphEdgeDo},
+		{From: "+deps", To: "./sub+lib", Type: GraphEdgeCopy},
+		{From: "+build", To: "+deps", Type: GraphEdgeFrom},
+		{From: "+build", To: "+deps", Type: GraphEdgeCopy},
+		{From: "+build", To: "github.com/earthly/hello-world+hello", Type: GraphEdgeBuild},
+		{From: "./sub+image", To: "./sub+base", Type: GraphEdgeFrom},
+		{From: "+build", To: "./sub+image", Type: GraphEdgeLoad},
+		{From: "+build", To: "+$TARGET", Type: GraphEdgeBuild},
+	}, graph.Edges)
+	Equal(t, []string{"+$TARGET"}, graph.Unresolved)
+	Contains(t, graph.DOT(), `"+build" -> "+deps" [label="FROM"];`)
+	Contains(t, graph.DOT(), `"+$TARGET" [style=dashed];`)
+	Contains(t, graph.Mermaid(), "n0 -->|FROM| n1")
+	Contains(t, graph.Mermaid(), "class n8 unresolved")
+
+	_, err = BuildGraph([]domain.Target{{LocalPath: ".", Target: "missing"}})
+	Error(t, err)
+}
+
+func TestBuildGraphImports(t *testing.T) {
+	dir, err := ioutil.TempDir("", "earthly-graph")
+	NoError(t, err)
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"Earthfile": `IMPORT ./sub AS lib
+IMPORT github.com/earthly/hello-world
+
+build:
+    FROM alpine:3.11
+    BUILD +lib+image
+    COPY +hello-world+hello/out ./
+    BUILD +missing+target
+`,
+		"sub/Earthfile": `image:
+    FROM alpine:3.11
+    SAVE IMAGE img
+`,
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+	}
+	wd, err := os.Getwd()
+	NoError(t, err)
+	NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	graph, err := BuildGraph([]domain.Target{{LocalPath: ".", Target: "build"}})
+	NoError(t, err)
+	Equal(t, []string{
+		"+build", "./sub+image", "github.com/earthly/hello-world+hello", "+missing+target",
+	}, graph.Targets)
+	Equal(t, []GraphEdge{
+		{From: "+build", To: "./sub+image", Type: GraphEdgeBuild},
+		{From: "+build", To: "github.com/earthly/hello-world+hello", Type: GraphEdgeCopy},
+		{From: "+build", To: "+missing+target", Type: GraphEdgeBuild},
+	}, graph.Edges)
+	Equal(t, []string{"+missing+target"}, graph.Unresolved)
+}
@@ -0,0 +1,128 @@
+package earthfile2llb
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/earthly/earthly/variables"
+)
+
+var heredocHeaderRegexp = regexp.MustCompile(
+	`^<<(-?)(?:([a-zA-Z_][a-zA-Z0-9_]*)|"([a-zA-Z_][a-zA-Z0-9_]*)"|'([a-zA-Z_][a-zA-Z0-9_]*)')$`)
+
+// heredoc is a here-document (<<EOF ... EOF) used as part of a RUN or COPY command.
+//
+// The lexer turns a heredoc into a single word, consisting of the header (e.g. <<EOF),
+// followed by a new line and the body.
+type heredoc struct {
+	header    string
+	delimiter string
+	// quoted is set if the delimiter is quoted, in which case the body is not subject to
+	// variable expansion.
+	quoted bool
+	// stripTabs is set for the <<- form, where leading tabs are removed from the body.
+	stripTabs bool
+	body      string
+}
+
+func parseHeredocHeader(header string) (heredoc, bool) {
+	m := heredocHeaderRegexp.FindStringSubmatch(header)
+	if m == nil {
+		return heredoc{}, false
+	}
+	h := heredoc{
+		header:    header,
+		stripTabs: m[1] == "-",
+	}
+	switch {
+	case m[2] != "":
+		h.delimiter = m[2]
+	case m[3] != "":
+		h.delimiter = m[3]
+		h.quoted = true
+	default:
+		h.delimiter = m[4]
+		h.quoted = true
+	}
+	return h, true
+}
+
+// parseHeredoc parses a word produced by the lexer for a heredoc. The second return value is
+// false if the word is not a heredoc.
+func parseHeredoc(word string) (heredoc, bool) {
+	parts := strings.SplitN(word, "\n", 2)
+	if len(parts) != 2 {
+		return heredoc{}, false
+	}
+	h, ok := parseHeredocHeader(parts[0])
+	if !ok {
+		return heredoc{}, false
+	}
+	h.body = parts[1]
+	return h, true
+}
+
+func isHeredoc(word string) bool {
+	_, ok := parseHeredoc(word)
+	return ok
+}
+
+// content returns the body of the heredoc, as it would be seen by the shell.
+func (h heredoc) content() string {
+	if !h.stripTabs {
+		return h.body
+	}
+	lines := strings.SplitAfter(h.body, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimLeft(line, "\t")
+	}
+	return strings.Join(lines, "")
+}
+
+// heredocRunArgs converts the args of a RUN command containing heredocs into a shell script.
+// A RUN consisting of a single heredoc executes its body as the script. Otherwise, the heredocs
+// are passed on to the shell as regular here-documents (e.g. RUN python3 <<EOF).
+func heredocRunArgs(args []string) []string {
+	if len(args) == 1 {
+		h, ok := parseHeredoc(args[0])
+		if ok {
+			return []string{h.content()}
+		}
+	}
+	var line []string
+	var bodies []string
+	for _, arg := range args {
+		h, ok := parseHeredoc(arg)
+		if !ok {
+			line = append(line, arg)
+			continue
+		}
+		line = append(line, h.header)
+		bodies = append(bodies, h.body+h.delimiter+"\n")
+	}
+	return []string{strings.Join(line, " ") + "\n" + strings.Join(bodies, "")}
+}
+
+// hasHeredoc returns whether any of the args is a heredoc.
+func hasHeredoc(args []string) bool {
+	for _, arg := range args {
+		if isHeredoc(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// expandVarRefs expands the references to constant variables within the body of a heredoc.
+// Unlike regular args, the body is not subject to quote removal or word splitting. References
+// which cannot be resolved are left as-is.
+func expandVarRefs(s string, vc *variables.Collection) string {
+	return varRefRegexp.ReplaceAllStringFunc(s, func(ref string) string {
+		name := strings.Trim(strings.TrimPrefix(ref, "$"), "{}")
+		v, active, found := vc.Get(name)
+		if !found || !active || !v.IsConstant() {
+			return ref
+		}
+		return v.ConstantValue()
+	})
+}
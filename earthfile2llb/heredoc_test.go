@@ -0,0 +1,54 @@
+package earthfile2llb
+
+import (
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParseHeredoc(t *testing.T) {
+	var tests = []struct {
+		word      string
+		delimiter string
+		quoted    bool
+		content   string
+		ok        bool
+	}{
+		{"<<EOF\necho hi\n", "EOF", false, "echo hi\n", true},
+		{"<<'EOF'\necho $HI\n", "EOF", true, "echo $HI\n", true},
+		{"<<\"EOF\"\necho $HI\n", "EOF", true, "echo $HI\n", true},
+		{"<<-EOF\n\techo hi\n\t\tnested\n", "EOF", false, "echo hi\nnested\n", true},
+		{"<<EOF\n", "EOF", false, "", true},
+		{"<<EOF", "", false, "", false},
+		{"<EOF\nbody\n", "", false, "", false},
+		{"<<'EOF\nbody\n", "", false, "", false},
+		{"echo", "", false, "", false},
+	}
+	for _, tt := range tests {
+		h, ok := parseHeredoc(tt.word)
+		Equal(t, tt.ok, ok, tt.word)
+		if !tt.ok {
+			continue
+		}
+		Equal(t, tt.delimiter, h.delimiter, tt.word)
+		Equal(t, tt.quoted, h.quoted, tt.word)
+		Equal(t, tt.content, h.content(), tt.word)
+	}
+}
+
+func TestHeredocRunArgs(t *testing.T) {
+	var tests = []struct {
+		args     []string
+		expected []string
+	}{
+		{[]string{"<<EOF\nset -e\necho hi\n"}, []string{"set -e\necho hi\n"}},
+		{[]string{"python3", "<<EOF\nprint(1)\n"}, []string{"python3 <<EOF\nprint(1)\nEOF\n"}},
+		{
+			[]string{"cat", "<<A\na\n", "<<-B\n\tb\n", ">", "/out"},
+			[]string{"cat <<A <<-B > /out\na\nA\n\tb\nB\n"},
+		},
+	}
+	for _, tt := range tests {
+		Equal(t, tt.expected, heredocRunArgs(tt.args))
+	}
+}
@@ -1,6 +1,9 @@
 package earthfile2llb
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/earthly/earthly/earthfile2llb/parser"
 )
@@ -14,6 +17,12 @@ type lexer struct {
 	afterNewLine                                 bool
 	tokenQueue                                   []antlr.Token
 	wsChannel, wsStart, wsStop, wsLine, wsColumn int
+
+	// heredocAllowed is set while lexing the args of a command which supports heredocs.
+	heredocAllowed bool
+	// lineQueue holds the remaining tokens of a line containing heredocs, which have been read
+	// ahead in order to get to the heredoc bodies.
+	lineQueue []antlr.Token
 }
 
 func newLexer(input antlr.CharStream) antlr.Lexer {
@@ -23,7 +32,7 @@ func newLexer(input antlr.CharStream) antlr.Lexer {
 }
 
 func (l *lexer) NextToken() antlr.Token {
-	peek := l.EarthLexer.NextToken()
+	peek := l.nextLineToken()
 	ret := peek
 	switch peek.GetTokenType() {
 	case parser.EarthLexerWS:
@@ -58,3 +67,92 @@ func (l *lexer) NextToken() antlr.Token {
 	}
 	return ret
 }
+
+// nextLineToken returns the next token of the underlying lexer, with heredocs expanded to
+// include their bodies.
+func (l *lexer) nextLineToken() antlr.Token {
+	if len(l.lineQueue) > 0 {
+		ret := l.lineQueue[0]
+		l.lineQueue = l.lineQueue[1:]
+		return ret
+	}
+	ret := l.EarthLexer.NextToken()
+	switch ret.GetTokenType() {
+	case parser.EarthLexerRUN, parser.EarthLexerCOPY:
+		l.heredocAllowed = true
+	case parser.EarthLexerNL:
+		l.heredocAllowed = false
+	case parser.EarthLexerAtom:
+		if l.heredocAllowed {
+			_, ok := parseHeredocHeader(ret.GetText())
+			if ok {
+				l.readHeredocs(ret)
+			}
+		}
+	}
+	return ret
+}
+
+// readHeredocs reads the rest of the line starting with the given heredoc token, followed by
+// the bodies of all the heredocs on that line. The text of the heredoc tokens is replaced
+// with the header, followed by a new line and the body.
+func (l *lexer) readHeredocs(first antlr.Token) {
+	heredocTokens := []antlr.Token{first}
+	for {
+		token := l.EarthLexer.NextToken()
+		l.lineQueue = append(l.lineQueue, token)
+		if token.GetTokenType() == parser.EarthLexerAtom {
+			_, ok := parseHeredocHeader(token.GetText())
+			if ok {
+				heredocTokens = append(heredocTokens, token)
+			}
+		}
+		if token.GetTokenType() == parser.EarthLexerNL || token.GetTokenType() == antlr.TokenEOF {
+			break
+		}
+	}
+	l.heredocAllowed = false
+	for _, token := range heredocTokens {
+		h, _ := parseHeredocHeader(token.GetText())
+		body, ok := l.readHeredocBody(h.delimiter)
+		if !ok {
+			l.GetErrorListenerDispatch().SyntaxError(
+				l, token, token.GetLine(), token.GetColumn(),
+				fmt.Sprintf("unterminated heredoc %s: no matching %s found", h.header, h.delimiter), nil)
+			return
+		}
+		token.SetText(h.header + "\n" + body)
+	}
+}
+
+// readHeredocBody consumes the lines of input up to and including the line containing only
+// the delimiter. The delimiter may be indented, in which case the same indentation is removed
+// from the lines of the body.
+func (l *lexer) readHeredocBody(delimiter string) (string, bool) {
+	input := l.GetInputStream()
+	var lines []string
+	for input.LA(1) != antlr.TokenEOF {
+		var line []rune
+		for input.LA(1) != antlr.TokenEOF && input.LA(1) != '\n' {
+			line = append(line, rune(input.LA(1)))
+			l.Interpreter.Consume(input)
+		}
+		if input.LA(1) == '\n' {
+			l.Interpreter.Consume(input)
+		}
+		lineStr := strings.TrimSuffix(string(line), "\r")
+		trimmed := strings.TrimLeft(lineStr, " \t")
+		if trimmed != delimiter {
+			lines = append(lines, lineStr)
+			continue
+		}
+		indent := lineStr[:len(lineStr)-len(trimmed)]
+		var body strings.Builder
+		for _, bodyLine := range lines {
+			body.WriteString(strings.TrimPrefix(bodyLine, indent))
+			body.WriteString("\n")
+		}
+		return body.String(), true
+	}
+	return "", false
+}
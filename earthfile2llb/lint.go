@@ -0,0 +1,612 @@
+package earthfile2llb
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/docker/docker/pkg/fileutils"
+	"github.com/earthly/earthly/buildcontext"
+	"github.com/earthly/earthly/domain"
+	"github.com/earthly/earthly/earthfile2llb/antlrhandler"
+	"github.com/earthly/earthly/earthfile2llb/parser"
+	"github.com/earthly/earthly/fileutil"
+	"github.com/earthly/earthly/variables"
+	"github.com/pkg/errors"
+)
+
+// The rules checked by Lint.
+const (
+	// LintCommandAfterSaveImage reports commands following a SAVE IMAGE, which are not part of
+	// the saved image.
+	LintCommandAfterSaveImage = "command-after-save-image"
+	// LintUnusedArg reports ARGs which are not referenced after being declared.
+	LintUnusedArg = "unused-arg"
+	// LintArgShadowsBuiltin reports ARGs declaring a default value for a builtin arg.
+	LintArgShadowsBuiltin = "arg-shadows-builtin"
+	// LintCopyExcluded reports COPY sources which are excluded from the build context.
+	LintCopyExcluded = "copy-excluded"
+	// LintUnknownTarget reports references to local targets which do not exist.
+	LintUnknownTarget = "unknown-target"
+	// LintSyntaxError reports syntax errors in the Earthfiles referenced by the linted one.
+	LintSyntaxError = "syntax-error"
+)
+
+// LintIssue is a rule violation found by Lint.
+type LintIssue struct {
+	Rule     string `json:"rule"`
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Message  string `json:"message"`
+}
+
+func (li LintIssue) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s (%s)", li.Filename, li.Line, li.Column, li.Message, li.Rule)
+}
+
+// lintDirectiveRegexp matches the comments disabling lint rules, either for the statement on
+// the same line (or on the next line, if the comment is on its own) or for the whole file.
+// If no rule is listed, all rules are disabled.
+var lintDirectiveRegexp = regexp.MustCompile(`^#\s*lint:(disable|disable-file)(\s.*)?$`)
+
+// Lint checks the Earthfile at the given path for common mistakes which would otherwise only
+// be found at build time.
+func Lint(filename string) ([]LintIssue, error) {
+	ll, err := collectStmts(filename)
+	if err != nil {
+		return nil, err
+	}
+	lc := &lintChecker{
+		filename: filename,
+		dir:      filepath.Dir(filename),
+		stmts:    ll.stmts,
+		targets:  make(map[string][]string),
+		invalid:  make(map[string]bool),
+	}
+	checks := []func() error{
+		lc.checkSaveImage,
+		lc.checkArgs,
+		lc.checkCopyExcluded,
+		lc.checkTargets,
+	}
+	for _, check := range checks {
+		err := check()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var issues []LintIssue
+	for _, li := range lc.issues {
+		if ll.fileDisabled[""] || ll.fileDisabled[li.Rule] ||
+			li.stmt.disabled[""] || li.stmt.disabled[li.Rule] {
+			continue
+		}
+		issues = append(issues, li.LintIssue)
+	}
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Filename != issues[j].Filename {
+			// The issues of the linted Earthfile come first.
+			return issues[i].Filename == filename
+		}
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Column < issues[j].Column
+	})
+	return issues, nil
+}
+
+// collectStmts parses the Earthfile at the given path and collects its statements.
+func collectStmts(filename string) (*lintListener, error) {
+	errorListener := antlrhandler.NewReturnErrorListener()
+	errorStrategy := antlrhandler.NewReturnErrorStrategy()
+	input, err := antlr.NewFileStream(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "new file stream %s", filename)
+	}
+	tree := newEarthfileTreeFromStream(input, errorListener, errorListener, errorStrategy)
+	err = syntaxError(filename, errorListener, errorStrategy)
+	if err != nil {
+		return nil, err
+	}
+	ll := &lintListener{
+		fileDisabled: make(map[string]bool),
+		pending:      make(map[string]bool),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(ll, tree)
+	return ll, nil
+}
+
+// lintStmt is a statement of the Earthfile, as seen by the lint checks.
+type lintStmt struct {
+	// target is the name of the target the statement belongs to, or "" for the base recipe.
+	target  string
+	command string
+	words   []string
+	line    int
+	column  int
+	// hasDefault is set for ARGs declaring a default value.
+	hasDefault bool
+	// disabled are the rules disabled for the statement.
+	disabled map[string]bool
+}
+
+// lintListener collects the statements of an Earthfile, together with the rules disabled
+// by comments.
+type lintListener struct {
+	*parser.BaseEarthParserListener
+	stmts []*lintStmt
+	// targets are the names of the targets of the Earthfile.
+	targets      []string
+	target       string
+	fileDisabled map[string]bool
+	// pending are the rules disabled for the next statement.
+	pending map[string]bool
+	// lineStmt is the statement whose line is terminated by the next NL token, if any.
+	lineStmt *lintStmt
+}
+
+func (l *lintListener) VisitTerminal(node antlr.TerminalNode) {
+	if node.GetSymbol().GetTokenType() == parser.EarthLexerEND {
+		l.newStmt(node.GetSymbol()).command = node.GetText()
+		return
+	}
+	if node.GetSymbol().GetTokenType() != parser.EarthLexerNL {
+		return
+	}
+	stmt := l.lineStmt
+	l.lineStmt = nil
+	m := lintDirectiveRegexp.FindStringSubmatch(lineComment(node.GetText()))
+	if m == nil {
+		return
+	}
+	disabled := l.pending
+	switch {
+	case m[1] == "disable-file":
+		disabled = l.fileDisabled
+	case stmt != nil:
+		disabled = stmt.disabled
+	}
+	rules := strings.Fields(strings.Replace(m[2], ",", " ", -1))
+	if len(rules) == 0 {
+		disabled[""] = true
+	}
+	for _, rule := range rules {
+		disabled[rule] = true
+	}
+}
+
+func (l *lintListener) EnterTargetHeader(c *parser.TargetHeaderContext) {
+	l.target = strings.TrimSuffix(c.GetText(), ":")
+	l.targets = append(l.targets, l.target)
+	l.pending = make(map[string]bool)
+}
+
+func (l *lintListener) EnterStmt(c *parser.StmtContext) {
+	if c.WithDockerStmt() != nil || c.IfStmt() != nil || c.ForStmt() != nil {
+		// Collected clause by clause.
+		return
+	}
+	stmt := l.newStmt(c.GetStart())
+	switch {
+	case c.EnvStmt() != nil:
+		ec := c.EnvStmt().(*parser.EnvStmtContext)
+		stmt.command = ec.ENV().GetText()
+		stmt.words = lintWords(keyValueWords(ec.EnvArgKey(), ec.EQUALS(), ec.EnvArgValue()))
+	case c.ArgStmt() != nil:
+		ac := c.ArgStmt().(*parser.ArgStmtContext)
+		stmt.command = ac.ARG().GetText()
+		stmt.words = lintWords(keyValueWords(ac.EnvArgKey(), ac.EQUALS(), ac.EnvArgValue()))
+		stmt.hasDefault = ac.EQUALS() != nil || len(stmt.words) > 1
+	default:
+		stmt.command, stmt.words = lintCommand(terminalNodes(c))
+	}
+}
+
+func (l *lintListener) ExitStmt(c *parser.StmtContext) {
+	l.lineStmt = l.stmts[len(l.stmts)-1]
+}
+
+func (l *lintListener) EnterWithDockerClause(c *parser.WithDockerClauseContext) {
+	l.enterClause(c)
+}
+
+func (l *lintListener) EnterIfClause(c *parser.IfClauseContext) {
+	l.enterClause(c)
+}
+
+func (l *lintListener) EnterElseIfClause(c *parser.ElseIfClauseContext) {
+	l.enterClause(c)
+}
+
+func (l *lintListener) EnterElseClause(c *parser.ElseClauseContext) {
+	l.enterClause(c)
+}
+
+func (l *lintListener) EnterForClause(c *parser.ForClauseContext) {
+	l.enterClause(c)
+}
+
+// enterClause collects the line introducing a block, such as IF or ELSE, as a statement.
+func (l *lintListener) enterClause(c antlr.ParserRuleContext) {
+	stmt := l.newStmt(c.GetStart())
+	stmt.command, stmt.words = lintCommand(clauseNodes(c))
+	l.lineStmt = stmt
+}
+
+// newStmt adds a statement starting at the given token.
+func (l *lintListener) newStmt(start antlr.Token) *lintStmt {
+	stmt := &lintStmt{
+		target:   l.target,
+		line:     start.GetLine(),
+		column:   start.GetColumn() + 1,
+		disabled: l.pending,
+	}
+	l.pending = make(map[string]bool)
+	l.stmts = append(l.stmts, stmt)
+	return stmt
+}
+
+// lintCommand returns the command and the words of a statement made of a command and its args.
+func lintCommand(nodes []antlr.TerminalNode) (string, []string) {
+	var command string
+	var words []string
+	for _, node := range nodes {
+		switch node.GetSymbol().GetTokenType() {
+		case parser.EarthLexerAtom:
+			words = append(words, node.GetText())
+		case parser.EarthLexerWS:
+		default:
+			if command == "" {
+				command = node.GetText()
+			}
+		}
+	}
+	return command, words
+}
+
+// lintIssue is a rule violation, before applying the rules disabled by comments.
+type lintIssue struct {
+	LintIssue
+	stmt *lintStmt
+}
+
+// lintChecker runs the lint checks over the statements of an Earthfile.
+type lintChecker struct {
+	filename string
+	dir      string
+	stmts    []*lintStmt
+	issues   []lintIssue
+	// targets are the targets of the Earthfiles referenced so far, by path.
+	targets map[string][]string
+	// invalid are the referenced Earthfiles which have syntax errors, by path.
+	invalid map[string]bool
+}
+
+func (lc *lintChecker) report(stmt *lintStmt, rule string, format string, args ...interface{}) {
+	lc.issues = append(lc.issues, lintIssue{
+		LintIssue: LintIssue{
+			Rule:     rule,
+			Filename: lc.filename,
+			Line:     stmt.line,
+			Column:   stmt.column,
+			Message:  fmt.Sprintf(format, args...),
+		},
+		stmt: stmt,
+	})
+}
+
+func (lc *lintChecker) checkSaveImage() error {
+	saved := false
+	target := ""
+	for _, stmt := range lc.stmts {
+		if stmt.target != target {
+			target = stmt.target
+			saved = false
+		}
+		switch stmt.command {
+		case "SAVE IMAGE":
+			saved = true
+		case "ELSE", "ELSE IF":
+			saved = false
+		case "SAVE ARTIFACT", "BUILD", "END":
+		default:
+			// RUN --push commands are meant to follow the image being pushed.
+			isPush := stmt.command == "RUN" && lintHasFlag(stmt.command, stmt.words, "push")
+			if saved && !isPush {
+				lc.report(stmt, LintCommandAfterSaveImage,
+					"%s after SAVE IMAGE is not part of the saved image", stmt.command)
+				saved = false
+			}
+		}
+	}
+	return nil
+}
+
+func (lc *lintChecker) checkArgs() error {
+	for i, stmt := range lc.stmts {
+		if stmt.command != "ARG" || len(stmt.words) == 0 {
+			continue
+		}
+		name := strings.SplitN(stmt.words[0], "=", 2)[0]
+		if stringIn(name, variables.BuiltinArgs) && stmt.hasDefault {
+			lc.report(stmt, LintArgShadowsBuiltin,
+				"ARG %s shadows a builtin arg; its default value is never used", name)
+		}
+		used := false
+		for _, other := range lc.stmts[i+1:] {
+			// Args declared in the base recipe are available to all targets.
+			if stmt.target != "" && other.target != stmt.target {
+				continue
+			}
+			if referencesVar(other.words, name) ||
+				stringIn(name, lintFlagValues(other.command, other.words, "build-arg")) {
+				// A --build-arg without a value passes on the value of the arg.
+				used = true
+				break
+			}
+		}
+		if !used {
+			lc.report(stmt, LintUnusedArg, "ARG %s is declared but never used", name)
+		}
+	}
+	return nil
+}
+
+func (lc *lintChecker) checkCopyExcluded() error {
+	excludes, err := buildcontext.ReadExcludes(lc.dir)
+	if err != nil {
+		return err
+	}
+	pm, err := fileutils.NewPatternMatcher(excludes)
+	if err != nil {
+		return errors.Wrapf(err, "parse %s", buildcontext.EarthIgnoreFile)
+	}
+	for _, stmt := range lc.stmts {
+		if stmt.command != "COPY" {
+			continue
+		}
+		args := lintArgs(stmt.command, stmt.words)
+		if len(args) < 2 {
+			continue
+		}
+		for _, src := range args[:len(args)-1] {
+			if strings.ContainsAny(src, "$*?[") || isHeredoc(src) {
+				continue
+			}
+			_, err := domain.ParseArtifact(src)
+			if err == nil {
+				continue
+			}
+			excluded, err := pm.Matches(filepath.Clean(src))
+			if err != nil {
+				return errors.Wrapf(err, "match %s", src)
+			}
+			if excluded {
+				lc.report(stmt, LintCopyExcluded,
+					"COPY source %s is excluded from the build context", src)
+			}
+		}
+	}
+	return nil
+}
+
+func (lc *lintChecker) checkTargets() error {
+	for _, stmt := range lc.stmts {
+		for _, ref := range stmt.targetRefs() {
+			err := lc.checkTarget(stmt, ref.ref)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (lc *lintChecker) checkTarget(stmt *lintStmt, ref string) error {
+	if !strings.Contains(ref, "+") || strings.Contains(ref, "$") {
+		return nil
+	}
+	target, err := domain.ParseTarget(ref)
+	if err != nil || !(target.IsLocalInternal() || target.IsLocalExternal()) {
+		return nil
+	}
+	filename := lc.filename
+	if target.IsLocalExternal() {
+		dir := target.LocalPath
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(lc.dir, dir)
+		}
+		var ok bool
+		filename, ok = buildFileIn(dir)
+		if !ok {
+			lc.report(stmt, LintUnknownTarget,
+				"no Earthfile nor build.earth file found for target %s", ref)
+			return nil
+		}
+	}
+	if lc.invalid[filename] {
+		return nil
+	}
+	targets, ok := lc.targets[filename]
+	if !ok {
+		ll, err := collectStmts(filename)
+		var se *SourceError
+		if errors.As(err, &se) {
+			lc.invalid[filename] = true
+			lc.issues = append(lc.issues, lintIssue{
+				LintIssue: LintIssue{
+					Rule:     LintSyntaxError,
+					Filename: se.Filename,
+					Line:     se.Line,
+					Column:   se.Column + 1,
+					Message:  se.Msg,
+				},
+				stmt: stmt,
+			})
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "get targets of %s", filename)
+		}
+		targets = ll.targets
+		lc.targets[filename] = targets
+	}
+	if target.Target != "base" && !stringIn(target.Target, targets) {
+		lc.report(stmt, LintUnknownTarget, "target %s is not defined", ref)
+	}
+	return nil
+}
+
+// stmtRef is a reference to a target made by a statement.
+type stmtRef struct {
+	ref string
+	// kind is the way the target is referenced, as one of the GraphEdge* constants.
+	kind string
+}
+
+// targetRefs returns the references to other targets made by the statement. References to
+// images are not included.
+func (stmt *lintStmt) targetRefs() []stmtRef {
+	var refs []stmtRef
+	args := lintArgs(stmt.command, stmt.words)
+	switch stmt.command {
+	case "FROM", "BUILD", "DO":
+		if len(args) > 0 && strings.Contains(args[0], "+") {
+			refs = append(refs, stmtRef{args[0], stmt.command})
+		}
+	case "COPY":
+		if len(args) < 2 {
+			return nil
+		}
+		for _, src := range args[:len(args)-1] {
+			artifact, err := domain.ParseArtifact(src)
+			if err == nil {
+				refs = append(refs, stmtRef{artifact.Target.String(), GraphEdgeCopy})
+			}
+		}
+	case "WITH DOCKER":
+		for _, load := range lintFlagValues(stmt.command, stmt.words, "load") {
+			parts := strings.SplitN(load, "=", 2)
+			refs = append(refs, stmtRef{parts[len(parts)-1], GraphEdgeLoad})
+		}
+	}
+	return refs
+}
+
+// buildFileIn returns the path of the build file in the given directory: its Earthfile, or
+// else its build.earth file.
+func buildFileIn(dir string) (string, bool) {
+	for _, name := range []string{"Earthfile", "build.earth"} {
+		filename := filepath.Join(dir, name)
+		if fileutil.FileExists(filename) {
+			return filename, true
+		}
+	}
+	return "", false
+}
+
+// lintArgs returns the positional args of a command, skipping its flags.
+func lintArgs(command string, words []string) []string {
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if word == "--" {
+			return words[i+1:]
+		}
+		if !strings.HasPrefix(word, "-") {
+			return words[i:]
+		}
+		if lintFlagHasValue(command, word) {
+			i++
+		}
+	}
+	return nil
+}
+
+// lintFlagValues returns the values passed to the given flag of a command.
+func lintFlagValues(command string, words []string, name string) []string {
+	var ret []string
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if word == "--" || !strings.HasPrefix(word, "-") {
+			break
+		}
+		parts := strings.SplitN(strings.TrimLeft(word, "-"), "=", 2)
+		hasValue := lintFlagHasValue(command, word)
+		if parts[0] == name && len(parts) == 2 {
+			ret = append(ret, parts[1])
+		} else if parts[0] == name && hasValue && i+1 < len(words) {
+			ret = append(ret, words[i+1])
+		}
+		if hasValue {
+			i++
+		}
+	}
+	return ret
+}
+
+// lintHasFlag returns whether the given boolean flag of a command is set.
+func lintHasFlag(command string, words []string, name string) bool {
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		if word == "--" || !strings.HasPrefix(word, "-") {
+			break
+		}
+		parts := strings.SplitN(strings.TrimLeft(word, "-"), "=", 2)
+		if parts[0] == name {
+			return len(parts) == 1 || parts[1] != "false"
+		}
+		if lintFlagHasValue(command, word) {
+			i++
+		}
+	}
+	return false
+}
+
+// lintFlagHasValue returns whether the flag word is followed by a separate value word.
+func lintFlagHasValue(command string, word string) bool {
+	name := strings.TrimLeft(word, "-")
+	if strings.Contains(name, "=") {
+		return false
+	}
+	for _, f := range formatFlags[command] {
+		if f.name == name {
+			return f.hasValue
+		}
+	}
+	return false
+}
+
+// referencesVar returns whether any of the words references the variable.
+func referencesVar(words []string, name string) bool {
+	for _, word := range words {
+		for _, ref := range varRefRegexp.FindAllStringSubmatch(word, -1) {
+			if strings.Trim(ref[1], "{}") == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func lintWords(words []formatWord) []string {
+	ret := make([]string, 0, len(words))
+	for _, word := range words {
+		ret = append(ret, word.text)
+	}
+	return ret
+}
+
+func stringIn(s string, list []string) bool {
+	for _, item := range list {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
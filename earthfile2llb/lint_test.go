@@ -0,0 +1,118 @@
+package earthfile2llb
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "earthly-lint")
+	NoError(t, err)
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"Earthfile": `FROM alpine:3.11
+ARG GLOBAL=1
+
+build:
+    ARG EARTHLY_TARGET=foo
+    ARG VERSION
+    ARG UNUSED
+    COPY src secret.txt ./
+    RUN echo "$GLOBAL" "${VERSION}" "$EARTHLY_TARGET"
+    SAVE IMAGE build:latest
+    RUN echo after
+
+refs:
+    FROM +build
+    BUILD +missing
+    BUILD ./sub+sub
+    BUILD ./sub+missing
+    BUILD ./nosub+sub
+    COPY +build/out ./
+    COPY +missing-artifact/out ./
+
+disabled:
+    ARG UNUSED # lint:disable unused-arg
+    # lint:disable
+    ARG UNUSED2=$UNUSED3
+    BUILD +missing # lint:disable unused-arg
+
+passed:
+    ARG PASSED
+    BUILD --build-arg PASSED +build
+
+blocks:
+    IF true
+        BUILD +missing
+    ELSE
+        ARG UNUSED4
+    END
+    BUILD ./broken+broken
+    BUILD ./broken+other
+
+push:
+    SAVE IMAGE --push push:latest
+    # pushed
+    RUN --push echo pushed
+    RUN echo after
+`,
+		".earthignore":     "secret.txt\n",
+		"sub/Earthfile":    "sub:\n    FROM alpine:3.11\n",
+		"broken/Earthfile": "broken:\n    IF true\n        RUN true\n",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		NoError(t, ioutil.WriteFile(p, []byte(content), 0644))
+	}
+
+	issues, err := Lint(filepath.Join(dir, "Earthfile"))
+	NoError(t, err)
+	type ruleLine struct {
+		rule string
+		line int
+	}
+	var actual []ruleLine
+	for _, li := range issues {
+		actual = append(actual, ruleLine{li.Rule, li.Line})
+	}
+	expected := []ruleLine{
+		{LintArgShadowsBuiltin, 5},
+		{LintUnusedArg, 7},
+		{LintCopyExcluded, 8},
+		{LintCommandAfterSaveImage, 11},
+		{LintUnknownTarget, 15},
+		{LintUnknownTarget, 17},
+		{LintUnknownTarget, 18},
+		{LintUnknownTarget, 20},
+		{LintUnknownTarget, 26},
+		{LintUnknownTarget, 34},
+		{LintUnusedArg, 36},
+		{LintCommandAfterSaveImage, 45},
+		{LintSyntaxError, 2},
+	}
+	Equal(t, expected, actual)
+	Equal(t, 5, issues[0].Column)
+	Equal(t, 5, issues[len(issues)-1].Column)
+	Equal(t, filepath.Join(dir, "broken", "Earthfile"), issues[len(issues)-1].Filename)
+}
+
+func TestLintDisableFile(t *testing.T) {
+	f, err := ioutil.TempFile("", "Earthfile")
+	NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString("# lint:disable-file unused-arg\nbuild:\n    ARG UNUSED\n    BUILD +missing\n")
+	NoError(t, err)
+	NoError(t, f.Close())
+
+	issues, err := Lint(f.Name())
+	NoError(t, err)
+	if Equal(t, 1, len(issues)) {
+		Equal(t, LintUnknownTarget, issues[0].Rule)
+		Equal(t, 4, issues[0].Line)
+	}
+}
@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"path"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/containerd/containerd/platforms"
 	"github.com/earthly/earthly/domain"
 	"github.com/earthly/earthly/earthfile2llb/parser"
+	"github.com/earthly/earthly/features"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
 )
@@ -28,6 +31,10 @@ type listener struct {
 	targetFound     bool
 	pushOnlyAllowed bool
 
+	// isCommand is set when executing a user-defined command (via DO), as opposed to a target.
+	isCommand       bool
+	commandDeclared bool
+
 	envArgKey   string
 	envArgValue string
 	labelKeys   []string
@@ -36,8 +43,18 @@ type listener struct {
 	withDocker    *WithDockerOpt
 	withDockerRan bool
 
+	// blockDepth is the number of IF, FOR and WITH DOCKER blocks entered by the tree walker.
+	// The walker skips their statements, which are executed explicitly once the whole IF, FOR
+	// or WITH DOCKER statement has been walked (see walkBlock).
+	blockDepth int
+
 	execMode  bool
 	stmtWords []string
+	// numStmts is the number of statements entered so far.
+	numStmts int
+	// stmtStart is the first token of the statement (or target header) being processed. It is
+	// used for locating errors.
+	stmtStart antlr.Token
 
 	err error
 }
@@ -52,10 +69,26 @@ func newListener(ctx context.Context, converter *Converter, executeTarget string
 	}
 }
 
+func newCommandListener(ctx context.Context, converter *Converter, executeCommand string, pushOnlyAllowed bool) *listener {
+	l := newListener(ctx, converter, executeCommand)
+	l.isCommand = true
+	l.pushOnlyAllowed = pushOnlyAllowed
+	return l
+}
+
 func (l *listener) Err() error {
 	if l.err != nil {
 		return l.err
 	}
+	if l.isCommand {
+		if !l.targetFound {
+			return fmt.Errorf("command %s not defined", l.executeTarget)
+		}
+		if !l.commandDeclared {
+			return fmt.Errorf("%s is not a user-defined command: missing COMMAND declaration", l.executeTarget)
+		}
+		return nil
+	}
 	if !l.targetFound {
 		return fmt.Errorf("target %s not defined", l.executeTarget)
 	}
@@ -63,6 +96,9 @@ func (l *listener) Err() error {
 }
 
 func (l *listener) EnterTargetHeader(c *parser.TargetHeaderContext) {
+	if l.err == nil {
+		l.stmtStart = c.GetStart()
+	}
 	l.currentTarget = strings.TrimSuffix(c.GetText(), ":")
 	if l.currentTarget == l.executeTarget {
 		if l.targetFound {
@@ -78,6 +114,10 @@ func (l *listener) EnterTargetHeader(c *parser.TargetHeaderContext) {
 		l.err = errors.New("target name cannot be \"base\" or \"secrets\"")
 		return
 	}
+	if l.isCommand {
+		// User-defined commands execute on top of the state of the caller.
+		return
+	}
 	// Apply implicit FROM +base
 	err := l.converter.From(l.ctx, "+base", nil, nil)
 	if err != nil {
@@ -90,26 +130,39 @@ func (l *listener) EnterStmts(c *parser.StmtsContext) {
 	if l.shouldSkip() {
 		return
 	}
+	if l.isCommand {
+		// Inherited from the caller.
+		return
+	}
 	l.pushOnlyAllowed = false
 }
 
-func (l *listener) ExitStmts(c *parser.StmtsContext) {
-	if l.shouldSkip() {
-		return
-	}
-	if l.withDocker != nil {
-		l.err = errors.New("no matching END found for WITH DOCKER")
-		return
-	}
+func (l *listener) EnterBlock(c *parser.BlockContext) {
+	l.blockDepth++
+}
+
+func (l *listener) ExitBlock(c *parser.BlockContext) {
+	l.blockDepth--
 }
 
 //
 // Commands.
 
 func (l *listener) EnterStmt(c *parser.StmtContext) {
+	l.numStmts++
 	if l.shouldSkip() {
 		return
 	}
+	l.stmtStart = c.GetStart()
+	if l.isCommand && !l.commandDeclared && c.CommandStmt() == nil {
+		l.err = fmt.Errorf(
+			"%s is not a user-defined command: the first statement must be COMMAND", l.executeTarget)
+		return
+	}
+	if l.converter.locally && !allowedLocally(c) {
+		l.err = fmt.Errorf("command not supported in LOCALLY: %s", c.GetText())
+		return
+	}
 	l.stmtWords = nil
 	l.envArgKey = ""
 	l.envArgValue = ""
@@ -130,7 +183,7 @@ func (l *listener) ExitFromStmt(c *parser.FromStmtContext) {
 	buildArgs := new(StringSliceFlag)
 	fs.Var(buildArgs, "build-arg", "A build arg override passed on to a referenced Earthly target")
 	platformStr := fs.String("platform", "", "The platform to use")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid FROM arguments %v", l.stmtWords)
 		return
@@ -178,7 +231,7 @@ func (l *listener) ExitFromDockerfileStmt(c *parser.FromDockerfileStmtContext) {
 	platformStr := fs.String("platform", "", "The platform to use")
 	dfTarget := fs.String("target", "", "The Dockerfile target to inherit from")
 	dfPath := fs.String("f", "", "Not supported")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid FROM DOCKERFILE arguments %v", l.stmtWords)
 		return
@@ -233,7 +286,7 @@ func (l *listener) ExitCopyStmt(c *parser.CopyStmtContext) {
 	platformStr := fs.String("platform", "", "The platform to use")
 	buildArgs := new(StringSliceFlag)
 	fs.Var(buildArgs, "build-arg", "A build arg override passed on to a referenced Earthly target")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid COPY arguments %v", l.stmtWords)
 		return
@@ -248,6 +301,17 @@ func (l *listener) ExitCopyStmt(c *parser.CopyStmtContext) {
 	}
 	srcs := fs.Args()[:fs.NArg()-1]
 	dest := l.expandArgs(fs.Arg(fs.NArg()-1), false)
+	if hasHeredoc(srcs) {
+		if !l.checkFeature(features.Heredocs) {
+			return
+		}
+		if *isDirCopy || *ifExists || *platformStr != "" || len(buildArgs.Args) != 0 {
+			l.err = fmt.Errorf("only --chown and --keep-ts are supported when copying heredocs: %v", l.stmtWords)
+			return
+		}
+		l.copyHeredocs(srcs, dest, *keepTs, l.expandArgs(*chown, false))
+		return
+	}
 	for i, ba := range buildArgs.Args {
 		buildArgs.Args[i] = l.expandArgs(ba, true)
 	}
@@ -292,7 +356,32 @@ func (l *listener) ExitCopyStmt(c *parser.CopyStmtContext) {
 			l.err = fmt.Errorf("build args not supported for non +artifact arguments case %v", l.stmtWords)
 			return
 		}
-		l.converter.CopyClassical(l.ctx, srcs, dest, *isDirCopy, *keepTs, *keepOwn, *chown)
+		err = l.converter.CopyClassical(l.ctx, srcs, dest, *isDirCopy, *keepTs, *keepOwn, *chown)
+		if err != nil {
+			l.err = errors.Wrapf(err, "copy classical")
+			return
+		}
+	}
+}
+
+// copyHeredocs creates files inline from the given heredocs. If dest is a directory (ends with
+// a slash) or if there are multiple heredocs, the files are named after the heredoc delimiters.
+func (l *listener) copyHeredocs(srcs []string, dest string, keepTs bool, chown string) {
+	for _, src := range srcs {
+		h, ok := parseHeredoc(src)
+		if !ok {
+			l.err = fmt.Errorf("combining heredocs and other sources in a single COPY command is not allowed: %v", srcs)
+			return
+		}
+		fileDest := dest
+		if len(srcs) > 1 || strings.HasSuffix(dest, "/") {
+			fileDest = path.Join(dest, h.delimiter)
+		}
+		err := l.converter.CopyHeredoc(l.ctx, fileDest, h.content(), !h.quoted, keepTs, chown)
+		if err != nil {
+			l.err = errors.Wrapf(err, "copy heredoc %s", h.header)
+			return
+		}
 	}
 }
 
@@ -319,7 +408,7 @@ func (l *listener) ExitRunStmt(c *parser.RunStmtContext) {
 	fs.Var(secrets, "secret", "Make available a secret")
 	mounts := new(StringSliceFlag)
 	fs.Var(mounts, "mount", "Mount a file or directory")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid RUN arguments %v", l.stmtWords)
 		return
@@ -341,10 +430,21 @@ func (l *listener) ExitRunStmt(c *parser.RunStmtContext) {
 		mounts.Args[i] = l.expandArgs(m, false)
 	}
 	// Note: Not expanding args for the run itself, as that will be take care of by the shell.
+	args := fs.Args()
+	if hasHeredoc(args) {
+		if !l.checkFeature(features.Heredocs) {
+			return
+		}
+		if !withShell {
+			l.err = fmt.Errorf("heredocs are not supported in the exec form of RUN: %s", c.GetText())
+			return
+		}
+		args = heredocRunArgs(args)
+	}
 
 	if l.withDocker == nil {
 		err = l.converter.Run(
-			l.ctx, fs.Args(), mounts.Args, secrets.Args, *privileged, *withEntrypoint, *withDocker,
+			l.ctx, args, mounts.Args, secrets.Args, *privileged, *withEntrypoint, *withDocker,
 			withShell, *pushFlag, *withSSH)
 		if err != nil {
 			l.err = errors.Wrap(err, "run")
@@ -367,7 +467,7 @@ func (l *listener) ExitRunStmt(c *parser.RunStmtContext) {
 		l.withDocker.Secrets = secrets.Args
 		l.withDocker.WithShell = withShell
 		l.withDocker.WithEntrypoint = *withEntrypoint
-		err = l.converter.WithDockerRun(l.ctx, fs.Args(), *l.withDocker)
+		err = l.converter.WithDockerRun(l.ctx, args, *l.withDocker)
 		if err != nil {
 			l.err = errors.Wrap(err, "with docker run")
 			return
@@ -387,7 +487,7 @@ func (l *listener) ExitSaveArtifact(c *parser.SaveArtifactContext) {
 	keepTs := fs.Bool("keep-ts", false, "Keep created time file timestamps")
 	keepOwn := fs.Bool("keep-own", false, "Keep owner info")
 	ifExists := fs.Bool("if-exists", false, "Do not fail if the artifact does not exist")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid SAVE arguments %v", l.stmtWords)
 		return
@@ -447,7 +547,7 @@ func (l *listener) ExitSaveImage(c *parser.SaveImageContext) {
 		"Use unencrypted connection for the push")
 	cacheFrom := new(StringSliceFlag)
 	fs.Var(cacheFrom, "cache-from", "Declare additional cache import as a Docker tag")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid SAVE IMAGE arguments %v", l.stmtWords)
 		return
@@ -495,7 +595,7 @@ func (l *listener) ExitBuildStmt(c *parser.BuildStmtContext) {
 	fs.Var(platformsStr, "platform", "The platform to build")
 	buildArgs := new(StringSliceFlag)
 	fs.Var(buildArgs, "build-arg", "A build arg override passed on to a referenced Earthly target")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid BUILD arguments %v", l.stmtWords)
 		return
@@ -698,7 +798,7 @@ func (l *listener) ExitGitCloneStmt(c *parser.GitCloneStmtContext) {
 	fs := flag.NewFlagSet("GIT CLONE", flag.ContinueOnError)
 	branch := fs.String("branch", "", "The git ref to use when cloning")
 	keepTs := fs.Bool("keep-ts", false, "Keep created time file timestamps")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid GIT CLONE arguments %v", l.stmtWords)
 		return
@@ -752,7 +852,7 @@ func (l *listener) ExitHealthcheckStmt(c *parser.HealthcheckStmtContext) {
 	retries := fs.Int(
 		"retries", 3,
 		"The number of retries before a container is considered unhealthy")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid HEALTHCHECK arguments %v", l.stmtWords)
 		return
@@ -795,7 +895,7 @@ func (l *listener) ExitWithDockerStmt(c *parser.WithDockerStmtContext) {
 		return
 	}
 	if l.pushOnlyAllowed {
-		l.err = fmt.Errorf("no non-push commands allowed after a --push: %s", c.GetText())
+		l.err = errors.New("no non-push commands allowed after a --push: WITH DOCKER")
 		return
 	}
 	if l.withDocker != nil {
@@ -815,7 +915,7 @@ func (l *listener) ExitWithDockerStmt(c *parser.WithDockerStmtContext) {
 	fs.Var(buildArgs, "build-arg", "A build arg override passed on to a referenced Earthly target")
 	pulls := new(StringSliceFlag)
 	fs.Var(pulls, "pull", "An image which is pulled and made available in the docker cache")
-	err := fs.Parse(l.stmtWords)
+	err := parseFlags(fs, l.stmtWords)
 	if err != nil {
 		l.err = errors.Wrapf(err, "invalid WITH DOCKER arguments %v", l.stmtWords)
 		return
@@ -874,21 +974,13 @@ func (l *listener) ExitWithDockerStmt(c *parser.WithDockerStmtContext) {
 			BuildArgs: buildArgs.Args,
 		})
 	}
-}
 
-func (l *listener) ExitEndStmt(c *parser.EndStmtContext) {
-	if l.shouldSkip() {
-		return
-	}
-	if len(l.stmtWords) != 0 {
-		l.err = fmt.Errorf("END does not take any arguments: %s", c.GetText())
-		return
-	}
-	if l.withDocker == nil {
-		l.err = fmt.Errorf("END can only be used to end a WITH DOCKER clause")
+	l.walkBlock(c.WithDockerClause().(*parser.WithDockerClauseContext).Block())
+	if l.err != nil {
 		return
 	}
 	if !l.withDockerRan {
+		l.stmtStart = c.GetStart()
 		l.err = fmt.Errorf("no RUN command found in WITH DOCKER")
 		return
 	}
@@ -900,14 +992,61 @@ func (l *listener) ExitAddStmt(c *parser.AddStmtContext) {
 	if l.shouldSkip() {
 		return
 	}
-	l.err = fmt.Errorf("command ADD not yet supported")
+	if !l.checkFeature(features.AddCommand) {
+		return
+	}
+	if l.pushOnlyAllowed {
+		l.err = fmt.Errorf("no non-push commands allowed after a --push: %s", c.GetText())
+		return
+	}
+	fs := flag.NewFlagSet("ADD", flag.ContinueOnError)
+	chown := fs.String("chown", "", "Apply a specific group and/or owner to the added files and directories")
+	keepTs := fs.Bool("keep-ts", false, "Keep created time file timestamps")
+	checksum := fs.String("checksum", "", "The checksum to verify a URL source against")
+	err := parseFlags(fs, l.stmtWords)
+	if err != nil {
+		l.err = errors.Wrapf(err, "invalid ADD arguments %v", l.stmtWords)
+		return
+	}
+	if fs.NArg() < 2 {
+		l.err = fmt.Errorf("not enough ADD arguments %v", l.stmtWords)
+		return
+	}
+	srcs := fs.Args()[:fs.NArg()-1]
+	for i, src := range srcs {
+		srcs[i] = l.expandArgs(src, false)
+	}
+	dest := l.expandArgs(fs.Arg(fs.NArg()-1), false)
+	*chown = l.expandArgs(*chown, false)
+	*checksum = l.expandArgs(*checksum, false)
+	err = l.converter.Add(l.ctx, srcs, dest, *checksum, *keepTs, *chown)
+	if err != nil {
+		l.err = errors.Wrap(err, "add")
+		return
+	}
 }
 
 func (l *listener) ExitStopsignalStmt(c *parser.StopsignalStmtContext) {
 	if l.shouldSkip() {
 		return
 	}
-	l.err = fmt.Errorf("command STOPSIGNAL not yet supported")
+	if !l.checkFeature(features.ShellCommands) {
+		return
+	}
+	if l.pushOnlyAllowed {
+		l.err = fmt.Errorf("no non-push commands allowed after a --push: %s", c.GetText())
+		return
+	}
+	if len(l.stmtWords) != 1 {
+		l.err = fmt.Errorf("invalid number of arguments for STOPSIGNAL: %v", l.stmtWords)
+		return
+	}
+	signal := l.expandArgs(l.stmtWords[0], false)
+	if !stopSignalRegexp.MatchString(signal) {
+		l.err = fmt.Errorf("invalid signal %s for STOPSIGNAL", signal)
+		return
+	}
+	l.converter.Stopsignal(l.ctx, signal)
 }
 
 func (l *listener) ExitOnbuildStmt(c *parser.OnbuildStmtContext) {
@@ -921,14 +1060,326 @@ func (l *listener) ExitShellStmt(c *parser.ShellStmtContext) {
 	if l.shouldSkip() {
 		return
 	}
-	l.err = fmt.Errorf("command SHELL not yet supported")
+	if !l.checkFeature(features.ShellCommands) {
+		return
+	}
+	if l.pushOnlyAllowed {
+		l.err = fmt.Errorf("no non-push commands allowed after a --push: %s", c.GetText())
+		return
+	}
+	// Only the exec (JSON) form is allowed, as in Dockerfiles.
+	var shell []string
+	err := json.Unmarshal([]byte(strings.Join(l.stmtWords, " ")), &shell)
+	if err != nil || len(shell) == 0 {
+		l.err = fmt.Errorf("SHELL requires the arguments to be a JSON array of strings: %v", l.stmtWords)
+		return
+	}
+	for i, arg := range shell {
+		shell[i] = l.expandArgs(arg, false)
+	}
+	l.converter.Shell(l.ctx, shell)
+}
+
+func (l *listener) ExitCommandStmt(c *parser.CommandStmtContext) {
+	if l.shouldSkip() {
+		return
+	}
+	if !l.checkFeature(features.UserCommands) {
+		return
+	}
+	if len(l.stmtWords) != 0 {
+		l.err = fmt.Errorf("COMMAND does not take any arguments: %s", c.GetText())
+		return
+	}
+	if !l.isCommand {
+		l.err = fmt.Errorf(
+			"target %s is a user-defined command and can only be invoked via DO", l.currentTarget)
+		return
+	}
+	if l.commandDeclared {
+		l.err = errors.New("COMMAND can only be used as the first statement of a command")
+		return
+	}
+	l.commandDeclared = true
+}
+
+func (l *listener) ExitDoStmt(c *parser.DoStmtContext) {
+	if l.shouldSkip() {
+		return
+	}
+	if !l.checkFeature(features.UserCommands) {
+		return
+	}
+	if l.withDocker != nil {
+		l.err = errors.New("DO not allowed in WITH DOCKER")
+		return
+	}
+	fs := flag.NewFlagSet("DO", flag.ContinueOnError)
+	err := parseFlags(fs, l.stmtWords)
+	if err != nil {
+		l.err = errors.Wrapf(err, "invalid DO arguments %v", l.stmtWords)
+		return
+	}
+	if fs.NArg() < 1 {
+		l.err = fmt.Errorf("invalid number of arguments for DO: %v", l.stmtWords)
+		return
+	}
+	commandName := l.expandArgs(fs.Arg(0), true)
+	buildArgs := make([]string, 0, fs.NArg()-1)
+	for _, arg := range fs.Args()[1:] {
+		if !strings.HasPrefix(arg, "--") {
+			l.err = fmt.Errorf("invalid DO argument %s, expected --<key>=<value>", arg)
+			return
+		}
+		buildArgs = append(buildArgs, l.expandArgs(strings.TrimPrefix(arg, "--"), true))
+	}
+	command, buildFilePath, err := l.converter.EnterCommand(l.ctx, commandName, buildArgs)
+	if err != nil {
+		l.err = errors.Wrapf(err, "apply DO %s", commandName)
+		return
+	}
+	cl := newCommandListener(l.ctx, l.converter, command.Target, l.pushOnlyAllowed)
+	err = walkEarthfile(buildFilePath, cl)
+	l.converter.ExitCommand(l.ctx)
+	if err != nil {
+		l.err = errors.Wrapf(err, "apply DO %s", commandName)
+		return
+	}
+	l.pushOnlyAllowed = cl.pushOnlyAllowed
 }
 
 func (l *listener) ExitGenericCommandStmt(c *parser.GenericCommandStmtContext) {
+	switch c.CommandName().GetText() {
+	case "VERSION":
+		// The version and the imports apply to all targets of the Earthfile, so they are
+		// processed regardless of the target being executed.
+		l.version(c)
+		return
+	case "IMPORT":
+		l.importStmt(c)
+		return
+	}
+	if l.shouldSkip() {
+		return
+	}
+	switch c.CommandName().GetText() {
+	case "LOCALLY":
+		if l.checkFeature(features.Locally) {
+			l.locally(c)
+		}
+	default:
+		l.err = invalidCommandError(c.CommandName().GetText())
+	}
+}
+
+func (l *listener) ExitIfStmt(c *parser.IfStmtContext) {
 	if l.shouldSkip() {
 		return
 	}
-	l.err = fmt.Errorf("invalid command %s", c.GetText())
+	if !l.checkFeature(features.IfCommand) {
+		return
+	}
+	if l.pushOnlyAllowed {
+		l.err = errors.New("no non-push commands allowed after a --push: IF")
+		return
+	}
+	if l.withDocker != nil {
+		l.err = errors.New("IF not allowed in WITH DOCKER")
+		return
+	}
+	ic := c.IfClause().(*parser.IfClauseContext)
+	ok, err := l.evalCondition(stmtWords(ic.StmtWords()))
+	if err != nil {
+		l.err = err
+		return
+	}
+	if ok {
+		l.walkBlock(ic.Block())
+		return
+	}
+	for _, elseIf := range c.AllElseIfClause() {
+		eic := elseIf.(*parser.ElseIfClauseContext)
+		ok, err := l.evalCondition(stmtWords(eic.StmtWords()))
+		if err != nil {
+			l.err = err
+			return
+		}
+		if ok {
+			l.walkBlock(eic.Block())
+			return
+		}
+	}
+	ec, ok := c.ElseClause().(*parser.ElseClauseContext)
+	if ok {
+		l.walkBlock(ec.Block())
+	}
+}
+
+func (l *listener) ExitForStmt(c *parser.ForStmtContext) {
+	if l.shouldSkip() {
+		return
+	}
+	if !l.checkFeature(features.ForCommand) {
+		return
+	}
+	if l.pushOnlyAllowed {
+		l.err = errors.New("no non-push commands allowed after a --push: FOR")
+		return
+	}
+	if l.withDocker != nil {
+		l.err = errors.New("FOR not allowed in WITH DOCKER")
+		return
+	}
+	fc := c.ForClause().(*parser.ForClauseContext)
+	words := stmtWords(fc.StmtWords())
+	if len(words) == 0 {
+		l.err = errors.New("not enough arguments for FOR")
+		return
+	}
+	fs := flag.NewFlagSet("FOR", flag.ContinueOnError)
+	separators := fs.String("sep", " \t\n", "The separators used to split the result of the expression")
+	privileged := fs.Bool("privileged", false, "Enable privileged mode")
+	withSSH := fs.Bool("ssh", false, "Make available the SSH agent of the host")
+	secrets := new(StringSliceFlag)
+	fs.Var(secrets, "secret", "Make available a secret")
+	mounts := new(StringSliceFlag)
+	fs.Var(mounts, "mount", "Mount a file or directory")
+	err := parseFlags(fs, words)
+	if err != nil {
+		l.err = errors.Wrapf(err, "invalid FOR arguments %v", words)
+		return
+	}
+	if fs.NArg() < 3 || fs.Arg(1) != "IN" {
+		l.err = fmt.Errorf("invalid FOR arguments %v, expected FOR <variable-name> IN <expression>", words)
+		return
+	}
+	varName := fs.Arg(0)
+	err = checkEnvVarName(varName)
+	if err != nil {
+		l.err = err
+		return
+	}
+	for i, s := range secrets.Args {
+		secrets.Args[i] = l.expandArgs(s, true)
+	}
+	for i, m := range mounts.Args {
+		mounts.Args[i] = l.expandArgs(m, false)
+	}
+	// Note: Not expanding args for the expression itself, as that will be take care of by the shell.
+	items, err := l.converter.For(
+		l.ctx, fs.Args()[2:], parseSeparators(*separators), mounts.Args, secrets.Args, *privileged, *withSSH)
+	if err != nil {
+		l.err = errors.Wrap(err, "for")
+		return
+	}
+	for _, item := range items {
+		l.converter.EnterForIteration(l.ctx, varName, item)
+		l.walkBlock(fc.Block())
+		l.converter.ExitForIteration(l.ctx)
+		if l.err != nil {
+			return
+		}
+	}
+}
+
+// evalCondition evaluates the condition of an IF or ELSE IF statement.
+func (l *listener) evalCondition(words []string) (bool, error) {
+	fs := flag.NewFlagSet("IF", flag.ContinueOnError)
+	privileged := fs.Bool("privileged", false, "Enable privileged mode")
+	withSSH := fs.Bool("ssh", false, "Make available the SSH agent of the host")
+	secrets := new(StringSliceFlag)
+	fs.Var(secrets, "secret", "Make available a secret")
+	mounts := new(StringSliceFlag)
+	fs.Var(mounts, "mount", "Mount a file or directory")
+	err := parseFlags(fs, words)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid IF arguments %v", words)
+	}
+	if fs.NArg() == 0 {
+		return false, errors.New("not enough arguments for IF")
+	}
+	for i, s := range secrets.Args {
+		secrets.Args[i] = l.expandArgs(s, true)
+	}
+	for i, m := range mounts.Args {
+		mounts.Args[i] = l.expandArgs(m, false)
+	}
+	// Note: Not expanding args for the condition itself, as that will be take care of by the shell.
+	ok, err := l.converter.If(l.ctx, fs.Args(), mounts.Args, secrets.Args, *privileged, *withSSH)
+	if err != nil {
+		return false, errors.Wrap(err, "if")
+	}
+	return ok, nil
+}
+
+func (l *listener) version(c *parser.GenericCommandStmtContext) {
+	if l.err != nil || l.blockDepth > 0 {
+		return
+	}
+	if l.currentTarget != "base" || l.numStmts != 1 {
+		if !l.shouldSkip() {
+			l.err = fmt.Errorf("VERSION can only be used as the first command of the Earthfile: %s", c.GetText())
+		}
+		return
+	}
+	words := stmtWords(c.StmtWords())
+	if len(words) != 1 {
+		l.err = fmt.Errorf("invalid VERSION arguments %v, expected VERSION <major>.<minor>", words)
+		return
+	}
+	err := l.converter.Version(l.ctx, words[0])
+	if err != nil {
+		l.err = errors.Wrap(err, "version")
+		return
+	}
+}
+
+func (l *listener) importStmt(c *parser.GenericCommandStmtContext) {
+	if l.err != nil || l.blockDepth > 0 {
+		return
+	}
+	if l.currentTarget != "base" {
+		if !l.shouldSkip() {
+			l.err = fmt.Errorf("IMPORT can only be used at the top of the Earthfile, before any targets: %s", c.GetText())
+		}
+		return
+	}
+	words := stmtWords(c.StmtWords())
+	var importStr, as string
+	switch {
+	case len(words) == 1:
+		importStr = words[0]
+	case len(words) == 3 && words[1] == "AS":
+		importStr = words[0]
+		as = words[2]
+	default:
+		l.err = fmt.Errorf("invalid IMPORT arguments %v, expected IMPORT <project-ref> [AS <alias>]", words)
+		return
+	}
+	if !l.checkFeature(features.Imports) {
+		return
+	}
+	err := l.converter.Import(l.ctx, importStr, as)
+	if err != nil {
+		l.err = errors.Wrap(err, "import")
+		return
+	}
+}
+
+func (l *listener) locally(c *parser.GenericCommandStmtContext) {
+	if len(l.stmtWords) != 0 {
+		l.err = fmt.Errorf("LOCALLY does not take any arguments: %s", c.GetText())
+		return
+	}
+	if l.pushOnlyAllowed {
+		l.err = fmt.Errorf("no non-push commands allowed after a --push: %s", c.GetText())
+		return
+	}
+	err := l.converter.Locally(l.ctx)
+	if err != nil {
+		l.err = errors.Wrap(err, "locally")
+		return
+	}
 }
 
 //
@@ -984,11 +1435,73 @@ func (l *listener) EnterStmtWord(c *parser.StmtWordContext) {
 	if l.shouldSkip() {
 		return
 	}
+	if isHeredoc(c.GetText()) {
+		// The body of heredocs is kept verbatim.
+		l.stmtWords = append(l.stmtWords, c.GetText())
+		return
+	}
 	l.stmtWords = append(l.stmtWords, replaceEscape(c.GetText()))
 }
 
+// walkBlock executes the statements of an IF, FOR or WITH DOCKER block, as if they were part of
+// the recipe being walked.
+func (l *listener) walkBlock(c parser.IBlockContext) {
+	bc, ok := c.(*parser.BlockContext)
+	if !ok {
+		// Empty block.
+		return
+	}
+	for _, stmt := range bc.Stmts().(*parser.StmtsContext).AllStmt() {
+		antlr.ParseTreeWalkerDefault.Walk(l, stmt)
+		if l.err != nil {
+			return
+		}
+	}
+}
+
+// stmtWords returns the given words of a statement. It is used where the words collected while
+// walking are not available: for statements processed regardless of the target being executed
+// and for the clauses of IF and FOR statements.
+func stmtWords(c parser.IStmtWordsContext) []string {
+	sw, ok := c.(*parser.StmtWordsContext)
+	if !ok {
+		return nil
+	}
+	var words []string
+	for _, word := range sw.AllStmtWord() {
+		words = append(words, replaceEscape(word.GetText()))
+	}
+	return words
+}
+
+// allowedLocally returns whether the statement can be executed in a LOCALLY target.
+func allowedLocally(c *parser.StmtContext) bool {
+	switch {
+	case c.RunStmt() != nil, c.CopyStmt() != nil, c.BuildStmt() != nil, c.ArgStmt() != nil,
+		c.EnvStmt() != nil, c.IfStmt() != nil, c.ForStmt() != nil, c.CommandStmt() != nil,
+		c.DoStmt() != nil, c.GenericCommandStmt() != nil:
+		return true
+	case c.SaveStmt() != nil:
+		sc, ok := c.SaveStmt().(*parser.SaveStmtContext)
+		return ok && sc.SaveArtifact() != nil
+	default:
+		return false
+	}
+}
+
 func (l *listener) shouldSkip() bool {
-	return l.err != nil || l.currentTarget != l.executeTarget
+	return l.err != nil || l.currentTarget != l.executeTarget || l.blockDepth > 0
+}
+
+// checkFeature returns whether the feature is available to the Earthfile, as determined by its
+// VERSION. If not, the error of the listener is set.
+func (l *listener) checkFeature(f features.Feature) bool {
+	err := l.converter.opt.Features.Check(f)
+	if err != nil {
+		l.err = err
+		return false
+	}
+	return true
 }
 
 func (l *listener) expandArgs(word string, keepPlusEscape bool) string {
@@ -1028,6 +1541,8 @@ func checkEnvVarName(str string) error {
 	return nil
 }
 
+var stopSignalRegexp = regexp.MustCompile("^(SIG[A-Z0-9+-]+|[0-9]+)$")
+
 var lineContinuationRegexp = regexp.MustCompile("\\\\(\\n|(\\r\\n))[\\t ]*")
 
 func replaceEscape(str string) string {
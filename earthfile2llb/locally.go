@@ -0,0 +1,272 @@
+package earthfile2llb
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/earthly/earthly/conslogging"
+	"github.com/earthly/earthly/domain"
+	"github.com/earthly/earthly/llbutil"
+	"github.com/earthly/earthly/states"
+
+	"github.com/moby/buildkit/client/llb"
+	gwclient "github.com/moby/buildkit/frontend/gateway/client"
+	"github.com/pkg/errors"
+)
+
+// Locally switches the target to LOCALLY mode, where subsequent commands are executed on the
+// host, within the directory of the target, rather than within BuildKit.
+func (c *Converter) Locally(ctx context.Context) error {
+	if c.mts.Final.Target.IsRemote() {
+		return errors.Errorf("LOCALLY cannot be used in remote target %s", c.mts.Final.Target.String())
+	}
+	c.nonSaveCommand()
+	c.locally = true
+	// The env vars of the base image do not apply to the host.
+	c.varCollection = c.varCollection.WithResetEnvVars()
+	return nil
+}
+
+// runLocally executes a RUN command on the host.
+func (c *Converter) runLocally(ctx context.Context, args, mounts, secretKeyValues []string, withEntrypoint, isWithShell, pushFlag bool) error {
+	runStr := fmt.Sprintf("RUN %s", strings.Join(args, " "))
+	err := checkLocallyRunOptions(mounts, secretKeyValues, withEntrypoint, pushFlag)
+	if err != nil {
+		return err
+	}
+	cmd, err := c.localCommand(ctx, args, isWithShell)
+	if err != nil {
+		return err
+	}
+	c.mts.Final.LocallyCommandStrs = append(c.mts.Final.LocallyCommandStrs, runStr)
+	if c.opt.DryRun {
+		return nil
+	}
+	console := c.localConsole()
+	console.Printf("--> %s\n", runStr)
+	w := &consoleWriter{console: console}
+	cmd.Stdout = w
+	cmd.Stderr = w
+	err = cmd.Run()
+	if err != nil {
+		return errors.Wrapf(err, "locally %s", runStr)
+	}
+	return nil
+}
+
+// runLocallyAndReadFile is the host equivalent of runAndReadFile. The output path is replaced
+// with a temporary file on the host. In a dry run, the command cannot be executed, and so its
+// output cannot be read.
+func (c *Converter) runLocallyAndReadFile(ctx context.Context, args []string, outputPath string, mounts, secretKeyValues []string, commandStr string) ([]byte, error) {
+	err := checkLocallyRunOptions(mounts, secretKeyValues, false, false)
+	if err != nil {
+		return nil, err
+	}
+	if c.opt.DryRun {
+		return nil, errors.Errorf("cannot evaluate LOCALLY %s in --dry-run", commandStr)
+	}
+	c.mts.Final.LocallyCommandStrs = append(c.mts.Final.LocallyCommandStrs, commandStr)
+	tmpFile, err := ioutil.TempFile("", "earthly-locally")
+	if err != nil {
+		return nil, errors.Wrap(err, "create temp file")
+	}
+	tmpPath := tmpFile.Name()
+	tmpFile.Close()
+	defer os.Remove(tmpPath)
+	finalArgs := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == outputPath {
+			arg = tmpPath
+		}
+		finalArgs = append(finalArgs, arg)
+	}
+	cmd, err := c.localCommand(ctx, finalArgs, true)
+	if err != nil {
+		return nil, err
+	}
+	console := c.localConsole()
+	console.Printf("--> %s\n", commandStr)
+	w := &consoleWriter{console: console}
+	cmd.Stdout = w
+	cmd.Stderr = w
+	err = cmd.Run()
+	if err != nil {
+		return nil, errors.Wrapf(err, "locally %s", commandStr)
+	}
+	dt, err := ioutil.ReadFile(tmpPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read output of %s", commandStr)
+	}
+	return dt, nil
+}
+
+// copyArtifactLocally copies the artifact of another target onto the host, relative to the
+// directory of the current target.
+func (c *Converter) copyArtifactLocally(ctx context.Context, mts *states.MultiTarget, artifact domain.Artifact, dest string, isDir bool, keepTs bool, ifExists bool) error {
+	err := checkLocalCopyDest(dest)
+	if err != nil {
+		return err
+	}
+	if mts.Final.ArtifactsState.Output() == nil {
+		if ifExists {
+			return nil
+		}
+		// ArtifactsState is scratch - no artifact has been copied.
+		return errors.Errorf("artifact %s not found; no SAVE ARTIFACT command was issued in %s", artifact.String(), artifact.Target.String())
+	}
+	copyStr := fmt.Sprintf(
+		"COPY %s%s%s %s",
+		strIf(isDir, "--dir "),
+		strIf(ifExists, "--if-exists "),
+		artifact.String(),
+		dest)
+	c.mts.Final.LocallyCommandStrs = append(c.mts.Final.LocallyCommandStrs, copyStr)
+	if c.opt.DryRun {
+		return nil
+	}
+	destState := llbutil.CopyOp(
+		mts.Final.ArtifactsState, []string{artifact.Artifact},
+		llbutil.ScratchWithPlatform(), dest, true, isDir, keepTs, "", ifExists,
+		llb.WithCustomNamef("%s%s (locally)", c.vertexPrefix(), copyStr))
+	ref, err := llbutil.StateToRef(ctx, c.opt.GwClient, destState, mts.Final.Platform, c.opt.CacheImports)
+	if err != nil {
+		return errors.Wrap(err, "state to ref solve artifact")
+	}
+	if ref == nil {
+		return nil
+	}
+	err = writeRefToHost(ctx, ref, "/", c.mts.Final.Target.LocalPath)
+	if err != nil {
+		return errors.Wrapf(err, "write artifact %s to %s", artifact.String(), dest)
+	}
+	return nil
+}
+
+// checkLocalCopyDest checks that the destination of a COPY in LOCALLY is within the directory
+// of the target.
+func checkLocalCopyDest(dest string) error {
+	if path.IsAbs(dest) {
+		return errors.Errorf("COPY %s: absolute paths are not supported in LOCALLY", dest)
+	}
+	cleaned := path.Clean(dest)
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return errors.Errorf("COPY %s: paths outside of the target directory are not supported in LOCALLY", dest)
+	}
+	return nil
+}
+
+// localSaveState returns a state containing the files to be saved via SAVE ARTIFACT from the
+// directory of the target on the host.
+func (c *Converter) localSaveState(saveFrom string) (llb.State, error) {
+	if path.IsAbs(saveFrom) {
+		return llb.State{}, errors.Errorf("SAVE ARTIFACT %s: absolute paths are not supported in LOCALLY", saveFrom)
+	}
+	// Use a separate local dir for each save, such that the files are synced again after the
+	// commands which may have modified them.
+	localName := fmt.Sprintf("locally-%s-%d", c.mts.Final.Salt, len(c.mts.Final.LocalDirs))
+	c.mts.Final.LocalDirs[localName] = c.mts.Final.Target.LocalPath
+	return llb.Local(
+		localName,
+		llb.IncludePatterns([]string{path.Clean(saveFrom)}),
+		llb.Platform(llbutil.DefaultPlatform()),
+		llb.WithCustomNamef("%sLOCALLY %s", c.vertexPrefix(), saveFrom),
+	), nil
+}
+
+func (c *Converter) localCommand(ctx context.Context, args []string, isWithShell bool) (*exec.Cmd, error) {
+	env := os.Environ()
+	for _, name := range c.varCollection.SortedActiveVariables() {
+		v, _, _ := c.varCollection.Get(name)
+		if !v.IsConstant() {
+			return nil, errors.Errorf("non-constant variable %s cannot be used in LOCALLY", name)
+		}
+		env = append(env, fmt.Sprintf("%s=%s", name, v.ConstantValue()))
+	}
+	finalArgs := args
+	if isWithShell {
+		finalArgs = append(append([]string{}, defaultShell...), strings.Join(args, " "))
+	}
+	if len(finalArgs) == 0 {
+		return nil, errors.New("no command to execute")
+	}
+	cmd := exec.CommandContext(ctx, finalArgs[0], finalArgs[1:]...)
+	cmd.Dir = filepath.FromSlash(c.mts.Final.Target.LocalPath)
+	cmd.Env = env
+	return cmd, nil
+}
+
+func (c *Converter) localConsole() conslogging.ConsoleLogger {
+	return c.opt.Console.WithPrefixAndSalt(c.mts.Final.Target.String(), c.mts.Final.Salt)
+}
+
+func checkLocallyRunOptions(mounts, secretKeyValues []string, withEntrypoint, pushFlag bool) error {
+	switch {
+	case len(mounts) != 0:
+		return errors.New("--mount is not supported in LOCALLY")
+	case len(secretKeyValues) != 0:
+		return errors.New("--secret is not supported in LOCALLY")
+	case withEntrypoint:
+		return errors.New("--entrypoint is not supported in LOCALLY")
+	case pushFlag:
+		return errors.New("--push is not supported in LOCALLY")
+	}
+	return nil
+}
+
+// writeRefToHost recursively writes the contents of refDir within the ref to hostDir.
+func writeRefToHost(ctx context.Context, ref gwclient.Reference, refDir string, hostDir string) error {
+	stats, err := ref.ReadDir(ctx, gwclient.ReadDirRequest{Path: refDir})
+	if err != nil {
+		return errors.Wrapf(err, "read dir %s", refDir)
+	}
+	for _, stat := range stats {
+		refPath := path.Join(refDir, stat.Path)
+		hostPath := filepath.Join(hostDir, filepath.FromSlash(stat.Path))
+		mode := os.FileMode(stat.Mode)
+		switch {
+		case mode.IsDir():
+			err = os.MkdirAll(hostPath, mode.Perm())
+			if err != nil {
+				return errors.Wrapf(err, "mkdir %s", hostPath)
+			}
+			err = writeRefToHost(ctx, ref, refPath, hostPath)
+			if err != nil {
+				return err
+			}
+		case mode&os.ModeSymlink != 0:
+			_ = os.Remove(hostPath)
+			err = os.Symlink(stat.Linkname, hostPath)
+			if err != nil {
+				return errors.Wrapf(err, "symlink %s", hostPath)
+			}
+		case mode.IsRegular():
+			dt, err := ref.ReadFile(ctx, gwclient.ReadRequest{Filename: refPath})
+			if err != nil {
+				return errors.Wrapf(err, "read file %s", refPath)
+			}
+			err = ioutil.WriteFile(hostPath, dt, mode.Perm())
+			if err != nil {
+				return errors.Wrapf(err, "write file %s", hostPath)
+			}
+		default:
+			return errors.Errorf("unsupported file type for %s", refPath)
+		}
+	}
+	return nil
+}
+
+// consoleWriter is an io.Writer which streams output to the console.
+type consoleWriter struct {
+	console conslogging.ConsoleLogger
+}
+
+func (cw *consoleWriter) Write(p []byte) (int, error) {
+	cw.console.PrintBytes(p)
+	return len(p), nil
+}
@@ -0,0 +1,37 @@
+package earthfile2llb
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestCheckLocalCopyDest(t *testing.T) {
+	var tests = []struct {
+		dest string
+		ok   bool
+	}{
+		{"./", true},
+		{"copied/", true},
+		{"a/../b", true},
+		{"..foo", true},
+		{"/etc/", false},
+		{"../", false},
+		{"..", false},
+		{"a/../../b", false},
+	}
+	for _, tt := range tests {
+		err := checkLocalCopyDest(tt.dest)
+		Equal(t, tt.ok, err == nil, tt.dest)
+	}
+}
+
+func TestRunLocallyAndReadFileDryRun(t *testing.T) {
+	c := &Converter{opt: ConvertOpt{DryRun: true}}
+	args := []string{"(", "[", "-f", "a", "]", ")", ";", "echo", "$?", ">", "/tmp/out"}
+	_, err := c.runLocallyAndReadFile(context.Background(), args, "/tmp/out", nil, nil, "IF [ -f a ]")
+	if Error(t, err) {
+		Equal(t, "cannot evaluate LOCALLY IF [ -f a ] in --dry-run", err.Error())
+	}
+}
@@ -0,0 +1,177 @@
+package earthfile2llb
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/earthly/earthly/earthfile2llb/antlrhandler"
+	"github.com/earthly/earthly/earthfile2llb/parser"
+	"github.com/pkg/errors"
+)
+
+// Outline is the structure of an Earthfile, as needed by editor integrations such as the
+// language server. Lines start at 1 and columns at 0.
+type Outline struct {
+	Targets []OutlineTarget
+	Args    []OutlineArg
+	// Errors are the syntax errors of the Earthfile.
+	Errors []*antlrhandler.SyntaxError
+}
+
+// OutlineTarget is a target of an Earthfile.
+type OutlineTarget struct {
+	Name   string
+	Line   int
+	Column int
+	// EndLine is the last line of the recipe of the target.
+	EndLine int
+	// Description is the comment block immediately above the target header, without the
+	// leading #s.
+	Description string
+}
+
+// OutlineArg is an ARG declaration.
+type OutlineArg struct {
+	Name string
+	// Target is the target declaring the arg, or "" for the base recipe.
+	Target string
+	Line   int
+	Column int
+	// Default is the default value of the arg, if HasDefault is set.
+	Default    string
+	HasDefault bool
+}
+
+// ParseOutline parses the contents of an Earthfile into its outline. Parsing carries on past
+// syntax errors, so that the outline covers as much of the Earthfile as possible.
+func ParseOutline(content string) *Outline {
+	errorListener := antlrhandler.NewReturnErrorListener()
+	tree := newEarthfileTreeFromStream(
+		antlr.NewInputStream(content), errorListener, errorListener, antlr.NewDefaultErrorStrategy())
+	ol := &outlineListener{outline: new(Outline)}
+	func() {
+		defer func() {
+			// The tree may be incomplete due to syntax errors.
+			recover()
+		}()
+		antlr.ParseTreeWalkerDefault.Walk(ol, tree)
+	}()
+	for _, err := range errorListener.Errs {
+		se, ok := err.(*antlrhandler.SyntaxError)
+		if ok {
+			ol.outline.Errors = append(ol.outline.Errors, se)
+		}
+	}
+	lines := strings.Split(content, "\n")
+	for i := range ol.outline.Targets {
+		ol.outline.Targets[i].Description = commentAbove(lines, ol.outline.Targets[i].Line)
+	}
+	return ol.outline
+}
+
+// ReadOutline reads the Earthfile at the given path and returns its outline. Unlike
+// ParseOutline, it fails if the Earthfile has syntax errors.
+func ReadOutline(filename string) (*Outline, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s", filename)
+	}
+	ol := ParseOutline(string(content))
+	if len(ol.Errors) > 0 {
+		se := ol.Errors[0]
+		return nil, newSourceError(filename, se.Line, se.Column, se.Token, errors.New("syntax error: "+se.Msg))
+	}
+	return ol, nil
+}
+
+// commentAbove returns the text of the comment lines immediately above the given line
+// (starting at 1). Lint directives are not included.
+func commentAbove(lines []string, line int) string {
+	var ret []string
+	for i := line - 2; i >= 0 && i < len(lines); i-- {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, "#") {
+			break
+		}
+		if lintDirectiveRegexp.MatchString(trimmed) {
+			continue
+		}
+		ret = append([]string{strings.TrimSpace(strings.TrimPrefix(trimmed, "#"))}, ret...)
+	}
+	return strings.Join(ret, "\n")
+}
+
+// TargetAt returns the target whose recipe contains the given line, or nil if the line is part
+// of the base recipe.
+func (o *Outline) TargetAt(line int) *OutlineTarget {
+	for i := range o.Targets {
+		if o.Targets[i].Line <= line && line <= o.Targets[i].EndLine {
+			return &o.Targets[i]
+		}
+	}
+	return nil
+}
+
+// Target returns the target with the given name, or nil if there is none.
+func (o *Outline) Target(name string) *OutlineTarget {
+	for i := range o.Targets {
+		if o.Targets[i].Name == name {
+			return &o.Targets[i]
+		}
+	}
+	return nil
+}
+
+type outlineListener struct {
+	*parser.BaseEarthParserListener
+	outline *Outline
+	target  string
+}
+
+func (l *outlineListener) EnterTargetHeader(c *parser.TargetHeaderContext) {
+	l.target = strings.TrimSuffix(c.GetText(), ":")
+	l.outline.Targets = append(l.outline.Targets, OutlineTarget{
+		Name:    l.target,
+		Line:    c.GetStart().GetLine(),
+		Column:  c.GetStart().GetColumn(),
+		EndLine: c.GetStart().GetLine(),
+	})
+}
+
+func (l *outlineListener) ExitTarget(c *parser.TargetContext) {
+	if len(l.outline.Targets) == 0 || c.GetStop() == nil {
+		return
+	}
+	l.outline.Targets[len(l.outline.Targets)-1].EndLine = c.GetStop().GetLine()
+}
+
+func (l *outlineListener) EnterArgStmt(c *parser.ArgStmtContext) {
+	key := c.EnvArgKey()
+	if key == nil {
+		return
+	}
+	start := key.GetStart()
+	arg := OutlineArg{
+		Name:       key.GetText(),
+		Target:     l.target,
+		Line:       start.GetLine(),
+		Column:     start.GetColumn(),
+		HasDefault: c.EQUALS() != nil,
+	}
+	if value, ok := c.EnvArgValue().(antlr.ParseTree); ok {
+		arg.Default = value.GetText()
+		arg.HasDefault = true
+	}
+	l.outline.Args = append(l.outline.Args, arg)
+}
+
+// CommandFlags returns the names of the flags of an Earthfile command, without the leading
+// dashes.
+func CommandFlags(command string) []string {
+	var ret []string
+	for _, f := range formatFlags[command] {
+		ret = append(ret, f.name)
+	}
+	return ret
+}
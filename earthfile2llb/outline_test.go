@@ -0,0 +1,39 @@
+package earthfile2llb
+
+import (
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParseOutline(t *testing.T) {
+	ol := ParseOutline(`FROM alpine:3.11
+ARG GLOBAL=1
+
+# Builds the binary.
+# lint:disable-file unused-arg
+# Requires VERSION.
+build:
+    ARG VERSION
+    ARG EMPTY=
+    ARG NAME=a b
+    RUN echo $VERSION
+
+# Not a description, due to the blank line.
+
+test:
+    FROM +build
+`)
+	Equal(t, 0, len(ol.Errors))
+	Equal(t, []OutlineTarget{
+		{Name: "build", Line: 7, Column: 0, EndLine: 11, Description: "Builds the binary.\nRequires VERSION."},
+		{Name: "test", Line: 15, Column: 0, EndLine: 16},
+	}, ol.Targets)
+	Equal(t, []OutlineArg{
+		{Name: "GLOBAL", Line: 2, Column: 4, Default: "1", HasDefault: true},
+		{Name: "VERSION", Target: "build", Line: 8, Column: 8},
+		{Name: "EMPTY", Target: "build", Line: 9, Column: 8, HasDefault: true},
+		{Name: "NAME", Target: "build", Line: 10, Column: 8, Default: "a b", HasDefault: true},
+	}, ol.Args)
+	True(t, ol.Target("test") == ol.TargetAt(16))
+}
@@ -33,6 +33,12 @@ HEALTHCHECK: 'HEALTHCHECK' -> pushMode(COMMAND_ARGS);
 SHELL: 'SHELL' -> pushMode(COMMAND_ARGS);
 WITH_DOCKER: 'WITH DOCKER' -> pushMode(COMMAND_ARGS);
 END: 'END' -> pushMode(COMMAND_ARGS);
+COMMAND: 'COMMAND' -> pushMode(COMMAND_ARGS);
+DO: 'DO' -> pushMode(COMMAND_ARGS);
+IF: 'IF' -> pushMode(COMMAND_ARGS);
+ELSE_IF: 'ELSE IF' -> pushMode(COMMAND_ARGS);
+ELSE: 'ELSE' -> pushMode(COMMAND_ARGS);
+FOR: 'FOR' -> pushMode(COMMAND_ARGS);
 Command: [A-Z]+ -> pushMode(COMMAND_ARGS);
 
 NL: WS? COMMENT? (EOF | CRLF);
@@ -73,6 +79,12 @@ HEALTHCHECK_R: HEALTHCHECK -> type(HEALTHCHECK), pushMode(COMMAND_ARGS);
 SHELL_R: SHELL -> type(SHELL), pushMode(COMMAND_ARGS);
 WITH_DOCKER_R: WITH_DOCKER -> type(WITH_DOCKER), pushMode(COMMAND_ARGS);
 END_R: END -> type(END), pushMode(COMMAND_ARGS);
+COMMAND_R: COMMAND -> type(COMMAND), pushMode(COMMAND_ARGS);
+DO_R: DO -> type(DO), pushMode(COMMAND_ARGS);
+IF_R: IF -> type(IF), pushMode(COMMAND_ARGS);
+ELSE_IF_R: ELSE_IF -> type(ELSE_IF), pushMode(COMMAND_ARGS);
+ELSE_R: ELSE -> type(ELSE), pushMode(COMMAND_ARGS);
+FOR_R: FOR -> type(FOR), pushMode(COMMAND_ARGS);
 Command_R: Command -> type(Command), pushMode(COMMAND_ARGS);
 
 NL_R: NL -> type(NL);
@@ -80,6 +92,8 @@ WS_R: WS -> type(WS);
 
 mode COMMAND_ARGS;
 
+// Heredocs (<<EOF ... EOF) in RUN and COPY are not part of this grammar. The header is lexed
+// as a regular Atom and the body is consumed by the wrapper lexer in earthfile2llb/lexer.go.
 Atom: (RegularAtomPart | QuotedAtomPart)+;
 fragment QuotedAtomPart: ('"' (~'"' | '\\"')* '"');
 fragment RegularAtomPart: ~([ \t\r\n\\"]) | EscapedAtomPart;
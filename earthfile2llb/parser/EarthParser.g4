@@ -37,7 +37,10 @@ stmt:
 	| healthcheckStmt
 	| shellStmt
 	| withDockerStmt
-	| endStmt
+	| ifStmt
+	| forStmt
+	| commandStmt
+	| doStmt
 	| genericCommandStmt;
 
 fromStmt: FROM (WS stmtWords)?;
@@ -87,8 +90,22 @@ onbuildStmt: ONBUILD (WS stmtWords)?;
 healthcheckStmt: HEALTHCHECK (WS stmtWords)?;
 shellStmt: SHELL (WS stmtWords)?;
 
-withDockerStmt: WITH_DOCKER (WS stmtWords)?;
-endStmt: END (WS stmtWords)?;
+// The statements of IF, FOR and WITH DOCKER blocks are nested within their clauses.
+withDockerStmt: withDockerClause NL+ WS? END;
+withDockerClause: WITH_DOCKER (WS stmtWords)? (NL+ block)?;
+
+ifStmt: ifClause (NL+ WS? elseIfClause)* (NL+ WS? elseClause)? NL+ WS? END;
+ifClause: IF (WS stmtWords)? (NL+ block)?;
+elseIfClause: ELSE_IF (WS stmtWords)? (NL+ block)?;
+elseClause: ELSE (NL+ block)?;
+
+forStmt: forClause NL+ WS? END;
+forClause: FOR (WS stmtWords)? (NL+ block)?;
+
+block: stmts;
+
+commandStmt: COMMAND (WS stmtWords)?;
+doStmt: DO (WS stmtWords)?;
 
 genericCommandStmt: commandName (WS stmtWords)?;
 commandName: Command;
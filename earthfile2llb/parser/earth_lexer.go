@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 36, 715,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 42, 812,
 	8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5,
 	9, 5, 4, 6, 9, 6, 4, 7, 9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4,
 	11, 9, 11, 4, 12, 9, 12, 4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16,
@@ -30,301 +30,343 @@ var serializedLexerAtn = []uint16{
 	63, 4, 64, 9, 64, 4, 65, 9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68,
 	4, 69, 9, 69, 4, 70, 9, 70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4,
 	74, 9, 74, 4, 75, 9, 75, 4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79,
-	9, 79, 3, 2, 6, 2, 165, 10, 2, 13, 2, 14, 2, 166, 3, 2, 3, 2, 3, 2, 3,
-	2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3,
-	4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3,
-	4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3,
-	6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3,
-	6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3,
-	7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3,
-	9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3,
-	10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12,
-	3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3,
-	13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15,
-	3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3,
-	16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17,
-	3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3,
-	18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19,
-	3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3,
-	20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21,
-	3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3,
-	21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23,
+	9, 79, 4, 80, 9, 80, 4, 81, 9, 81, 4, 82, 9, 82, 4, 83, 9, 83, 4, 84, 9,
+	84, 4, 85, 9, 85, 4, 86, 9, 86, 4, 87, 9, 87, 4, 88, 9, 88, 4, 89, 9, 89,
+	4, 90, 9, 90, 4, 91, 9, 91, 3, 2, 6, 2, 189, 10, 2, 13, 2, 14, 2, 190,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4,
+	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
+	3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
+	3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6,
+	3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
+	3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9,
+	3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3,
+	10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11,
+	3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3,
+	13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14,
+	3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
+	15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17,
+	3, 17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3,
+	18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19,
+	3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3,
+	20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20,
+	3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3,
+	21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22,
 	3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3,
-	24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 25,
-	3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3,
-	25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26,
-	3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3,
-	27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 29,
-	6, 29, 434, 10, 29, 13, 29, 14, 29, 435, 3, 29, 3, 29, 3, 30, 5, 30, 441,
-	10, 30, 3, 30, 5, 30, 444, 10, 30, 3, 30, 3, 30, 5, 30, 448, 10, 30, 3,
-	31, 3, 31, 3, 31, 7, 31, 453, 10, 31, 12, 31, 14, 31, 456, 11, 31, 3, 32,
-	3, 32, 3, 32, 5, 32, 461, 10, 32, 3, 33, 3, 33, 7, 33, 465, 10, 33, 12,
-	33, 14, 33, 468, 11, 33, 3, 34, 3, 34, 7, 34, 472, 10, 34, 12, 34, 14,
-	34, 475, 11, 34, 3, 34, 3, 34, 3, 34, 7, 34, 480, 10, 34, 12, 34, 14, 34,
-	483, 11, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3,
-	36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38,
-	3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3,
-	40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42,
-	3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3,
-	45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47,
-	3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3,
-	49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51,
-	3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3,
-	53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55,
-	3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3,
-	57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59,
-	3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3,
-	61, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 6, 65, 635, 10, 65, 13, 65, 14, 65,
-	636, 3, 66, 3, 66, 3, 66, 3, 66, 7, 66, 643, 10, 66, 12, 66, 14, 66, 646,
-	11, 66, 3, 66, 3, 66, 3, 67, 3, 67, 5, 67, 652, 10, 67, 3, 68, 3, 68, 3,
-	68, 3, 68, 7, 68, 658, 10, 68, 12, 68, 14, 68, 661, 11, 68, 5, 68, 663,
-	10, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 6, 72, 680, 10, 72, 13, 72, 14,
-	72, 681, 3, 72, 3, 72, 3, 73, 3, 73, 5, 73, 688, 10, 73, 3, 74, 3, 74,
-	3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3,
-	76, 3, 77, 3, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 78, 3, 78, 3, 78, 3, 79,
-	3, 79, 3, 79, 3, 79, 2, 2, 80, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10,
-	19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19,
-	37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28,
-	55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 2, 69, 2, 71, 2, 73,
-	2, 75, 2, 77, 2, 79, 2, 81, 2, 83, 2, 85, 2, 87, 2, 89, 2, 91, 2, 93, 2,
-	95, 2, 97, 2, 99, 2, 101, 2, 103, 2, 105, 2, 107, 2, 109, 2, 111, 2, 113,
-	2, 115, 2, 117, 2, 119, 2, 121, 2, 123, 2, 125, 2, 127, 2, 129, 2, 131,
-	2, 133, 35, 135, 2, 137, 2, 139, 2, 141, 2, 143, 2, 145, 36, 147, 2, 149,
-	2, 151, 2, 153, 2, 155, 2, 157, 2, 159, 2, 161, 2, 7, 2, 3, 4, 5, 6, 9,
-	6, 2, 47, 48, 50, 59, 67, 92, 99, 124, 3, 2, 67, 92, 4, 2, 11, 11, 34,
-	34, 4, 2, 12, 12, 15, 15, 3, 2, 36, 36, 7, 2, 11, 12, 15, 15, 34, 34, 36,
-	36, 94, 94, 7, 2, 11, 12, 15, 15, 34, 34, 36, 36, 63, 63, 2, 726, 2, 7,
-	3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2,
-	15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2,
-	2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2,
-	2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2,
-	2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3,
-	2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53,
-	3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2,
-	61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 3, 73, 3, 2, 2, 2,
-	3, 75, 3, 2, 2, 2, 3, 77, 3, 2, 2, 2, 3, 79, 3, 2, 2, 2, 3, 81, 3, 2, 2,
-	2, 3, 83, 3, 2, 2, 2, 3, 85, 3, 2, 2, 2, 3, 87, 3, 2, 2, 2, 3, 89, 3, 2,
-	2, 2, 3, 91, 3, 2, 2, 2, 3, 93, 3, 2, 2, 2, 3, 95, 3, 2, 2, 2, 3, 97, 3,
-	2, 2, 2, 3, 99, 3, 2, 2, 2, 3, 101, 3, 2, 2, 2, 3, 103, 3, 2, 2, 2, 3,
-	105, 3, 2, 2, 2, 3, 107, 3, 2, 2, 2, 3, 109, 3, 2, 2, 2, 3, 111, 3, 2,
-	2, 2, 3, 113, 3, 2, 2, 2, 3, 115, 3, 2, 2, 2, 3, 117, 3, 2, 2, 2, 3, 119,
-	3, 2, 2, 2, 3, 121, 3, 2, 2, 2, 3, 123, 3, 2, 2, 2, 3, 125, 3, 2, 2, 2,
-	3, 127, 3, 2, 2, 2, 3, 129, 3, 2, 2, 2, 3, 131, 3, 2, 2, 2, 4, 133, 3,
-	2, 2, 2, 4, 141, 3, 2, 2, 2, 4, 143, 3, 2, 2, 2, 5, 145, 3, 2, 2, 2, 5,
-	147, 3, 2, 2, 2, 5, 151, 3, 2, 2, 2, 5, 153, 3, 2, 2, 2, 6, 155, 3, 2,
-	2, 2, 6, 157, 3, 2, 2, 2, 6, 159, 3, 2, 2, 2, 6, 161, 3, 2, 2, 2, 7, 164,
-	3, 2, 2, 2, 9, 172, 3, 2, 2, 2, 11, 179, 3, 2, 2, 2, 13, 197, 3, 2, 2,
-	2, 15, 204, 3, 2, 2, 2, 17, 220, 3, 2, 2, 2, 19, 233, 3, 2, 2, 2, 21, 239,
-	3, 2, 2, 2, 23, 248, 3, 2, 2, 2, 25, 257, 3, 2, 2, 2, 27, 263, 3, 2, 2,
-	2, 29, 269, 3, 2, 2, 2, 31, 277, 3, 2, 2, 2, 33, 285, 3, 2, 2, 2, 35, 295,
-	3, 2, 2, 2, 37, 302, 3, 2, 2, 2, 39, 308, 3, 2, 2, 2, 41, 321, 3, 2, 2,
-	2, 43, 333, 3, 2, 2, 2, 45, 347, 3, 2, 2, 2, 47, 361, 3, 2, 2, 2, 49, 367,
-	3, 2, 2, 2, 51, 380, 3, 2, 2, 2, 53, 390, 3, 2, 2, 2, 55, 404, 3, 2, 2,
-	2, 57, 412, 3, 2, 2, 2, 59, 426, 3, 2, 2, 2, 61, 433, 3, 2, 2, 2, 63, 440,
-	3, 2, 2, 2, 65, 449, 3, 2, 2, 2, 67, 460, 3, 2, 2, 2, 69, 462, 3, 2, 2,
-	2, 71, 469, 3, 2, 2, 2, 73, 484, 3, 2, 2, 2, 75, 489, 3, 2, 2, 2, 77, 494,
-	3, 2, 2, 2, 79, 499, 3, 2, 2, 2, 81, 504, 3, 2, 2, 2, 83, 509, 3, 2, 2,
-	2, 85, 514, 3, 2, 2, 2, 87, 519, 3, 2, 2, 2, 89, 524, 3, 2, 2, 2, 91, 529,
-	3, 2, 2, 2, 93, 534, 3, 2, 2, 2, 95, 539, 3, 2, 2, 2, 97, 544, 3, 2, 2,
-	2, 99, 549, 3, 2, 2, 2, 101, 554, 3, 2, 2, 2, 103, 559, 3, 2, 2, 2, 105,
-	564, 3, 2, 2, 2, 107, 569, 3, 2, 2, 2, 109, 574, 3, 2, 2, 2, 111, 579,
-	3, 2, 2, 2, 113, 584, 3, 2, 2, 2, 115, 589, 3, 2, 2, 2, 117, 594, 3, 2,
-	2, 2, 119, 599, 3, 2, 2, 2, 121, 604, 3, 2, 2, 2, 123, 609, 3, 2, 2, 2,
-	125, 614, 3, 2, 2, 2, 127, 619, 3, 2, 2, 2, 129, 624, 3, 2, 2, 2, 131,
-	628, 3, 2, 2, 2, 133, 634, 3, 2, 2, 2, 135, 638, 3, 2, 2, 2, 137, 651,
-	3, 2, 2, 2, 139, 662, 3, 2, 2, 2, 141, 664, 3, 2, 2, 2, 143, 669, 3, 2,
-	2, 2, 145, 673, 3, 2, 2, 2, 147, 679, 3, 2, 2, 2, 149, 687, 3, 2, 2, 2,
-	151, 689, 3, 2, 2, 2, 153, 694, 3, 2, 2, 2, 155, 698, 3, 2, 2, 2, 157,
-	702, 3, 2, 2, 2, 159, 706, 3, 2, 2, 2, 161, 711, 3, 2, 2, 2, 163, 165,
-	9, 2, 2, 2, 164, 163, 3, 2, 2, 2, 165, 166, 3, 2, 2, 2, 166, 164, 3, 2,
-	2, 2, 166, 167, 3, 2, 2, 2, 167, 168, 3, 2, 2, 2, 168, 169, 7, 60, 2, 2,
-	169, 170, 3, 2, 2, 2, 170, 171, 8, 2, 2, 2, 171, 8, 3, 2, 2, 2, 172, 173,
-	7, 72, 2, 2, 173, 174, 7, 84, 2, 2, 174, 175, 7, 81, 2, 2, 175, 176, 7,
-	79, 2, 2, 176, 177, 3, 2, 2, 2, 177, 178, 8, 3, 3, 2, 178, 10, 3, 2, 2,
-	2, 179, 180, 7, 72, 2, 2, 180, 181, 7, 84, 2, 2, 181, 182, 7, 81, 2, 2,
-	182, 183, 7, 79, 2, 2, 183, 184, 7, 34, 2, 2, 184, 185, 7, 70, 2, 2, 185,
-	186, 7, 81, 2, 2, 186, 187, 7, 69, 2, 2, 187, 188, 7, 77, 2, 2, 188, 189,
-	7, 71, 2, 2, 189, 190, 7, 84, 2, 2, 190, 191, 7, 72, 2, 2, 191, 192, 7,
-	75, 2, 2, 192, 193, 7, 78, 2, 2, 193, 194, 7, 71, 2, 2, 194, 195, 3, 2,
-	2, 2, 195, 196, 8, 4, 3, 2, 196, 12, 3, 2, 2, 2, 197, 198, 7, 69, 2, 2,
-	198, 199, 7, 81, 2, 2, 199, 200, 7, 82, 2, 2, 200, 201, 7, 91, 2, 2, 201,
-	202, 3, 2, 2, 2, 202, 203, 8, 5, 3, 2, 203, 14, 3, 2, 2, 2, 204, 205, 7,
-	85, 2, 2, 205, 206, 7, 67, 2, 2, 206, 207, 7, 88, 2, 2, 207, 208, 7, 71,
-	2, 2, 208, 209, 7, 34, 2, 2, 209, 210, 7, 67, 2, 2, 210, 211, 7, 84, 2,
-	2, 211, 212, 7, 86, 2, 2, 212, 213, 7, 75, 2, 2, 213, 214, 7, 72, 2, 2,
-	214, 215, 7, 67, 2, 2, 215, 216, 7, 69, 2, 2, 216, 217, 7, 86, 2, 2, 217,
-	218, 3, 2, 2, 2, 218, 219, 8, 6, 3, 2, 219, 16, 3, 2, 2, 2, 220, 221, 7,
-	85, 2, 2, 221, 222, 7, 67, 2, 2, 222, 223, 7, 88, 2, 2, 223, 224, 7, 71,
-	2, 2, 224, 225, 7, 34, 2, 2, 225, 226, 7, 75, 2, 2, 226, 227, 7, 79, 2,
-	2, 227, 228, 7, 67, 2, 2, 228, 229, 7, 73, 2, 2, 229, 230, 7, 71, 2, 2,
-	230, 231, 3, 2, 2, 2, 231, 232, 8, 7, 3, 2, 232, 18, 3, 2, 2, 2, 233, 234,
-	7, 84, 2, 2, 234, 235, 7, 87, 2, 2, 235, 236, 7, 80, 2, 2, 236, 237, 3,
-	2, 2, 2, 237, 238, 8, 8, 3, 2, 238, 20, 3, 2, 2, 2, 239, 240, 7, 71, 2,
-	2, 240, 241, 7, 90, 2, 2, 241, 242, 7, 82, 2, 2, 242, 243, 7, 81, 2, 2,
-	243, 244, 7, 85, 2, 2, 244, 245, 7, 71, 2, 2, 245, 246, 3, 2, 2, 2, 246,
-	247, 8, 9, 3, 2, 247, 22, 3, 2, 2, 2, 248, 249, 7, 88, 2, 2, 249, 250,
-	7, 81, 2, 2, 250, 251, 7, 78, 2, 2, 251, 252, 7, 87, 2, 2, 252, 253, 7,
-	79, 2, 2, 253, 254, 7, 71, 2, 2, 254, 255, 3, 2, 2, 2, 255, 256, 8, 10,
-	3, 2, 256, 24, 3, 2, 2, 2, 257, 258, 7, 71, 2, 2, 258, 259, 7, 80, 2, 2,
-	259, 260, 7, 88, 2, 2, 260, 261, 3, 2, 2, 2, 261, 262, 8, 11, 4, 2, 262,
-	26, 3, 2, 2, 2, 263, 264, 7, 67, 2, 2, 264, 265, 7, 84, 2, 2, 265, 266,
-	7, 73, 2, 2, 266, 267, 3, 2, 2, 2, 267, 268, 8, 12, 4, 2, 268, 28, 3, 2,
-	2, 2, 269, 270, 7, 78, 2, 2, 270, 271, 7, 67, 2, 2, 271, 272, 7, 68, 2,
-	2, 272, 273, 7, 71, 2, 2, 273, 274, 7, 78, 2, 2, 274, 275, 3, 2, 2, 2,
-	275, 276, 8, 13, 5, 2, 276, 30, 3, 2, 2, 2, 277, 278, 7, 68, 2, 2, 278,
-	279, 7, 87, 2, 2, 279, 280, 7, 75, 2, 2, 280, 281, 7, 78, 2, 2, 281, 282,
-	7, 70, 2, 2, 282, 283, 3, 2, 2, 2, 283, 284, 8, 14, 3, 2, 284, 32, 3, 2,
-	2, 2, 285, 286, 7, 89, 2, 2, 286, 287, 7, 81, 2, 2, 287, 288, 7, 84, 2,
-	2, 288, 289, 7, 77, 2, 2, 289, 290, 7, 70, 2, 2, 290, 291, 7, 75, 2, 2,
-	291, 292, 7, 84, 2, 2, 292, 293, 3, 2, 2, 2, 293, 294, 8, 15, 3, 2, 294,
-	34, 3, 2, 2, 2, 295, 296, 7, 87, 2, 2, 296, 297, 7, 85, 2, 2, 297, 298,
-	7, 71, 2, 2, 298, 299, 7, 84, 2, 2, 299, 300, 3, 2, 2, 2, 300, 301, 8,
-	16, 3, 2, 301, 36, 3, 2, 2, 2, 302, 303, 7, 69, 2, 2, 303, 304, 7, 79,
-	2, 2, 304, 305, 7, 70, 2, 2, 305, 306, 3, 2, 2, 2, 306, 307, 8, 17, 3,
-	2, 307, 38, 3, 2, 2, 2, 308, 309, 7, 71, 2, 2, 309, 310, 7, 80, 2, 2, 310,
-	311, 7, 86, 2, 2, 311, 312, 7, 84, 2, 2, 312, 313, 7, 91, 2, 2, 313, 314,
-	7, 82, 2, 2, 314, 315, 7, 81, 2, 2, 315, 316, 7, 75, 2, 2, 316, 317, 7,
-	80, 2, 2, 317, 318, 7, 86, 2, 2, 318, 319, 3, 2, 2, 2, 319, 320, 8, 18,
-	3, 2, 320, 40, 3, 2, 2, 2, 321, 322, 7, 73, 2, 2, 322, 323, 7, 75, 2, 2,
-	323, 324, 7, 86, 2, 2, 324, 325, 7, 34, 2, 2, 325, 326, 7, 69, 2, 2, 326,
-	327, 7, 78, 2, 2, 327, 328, 7, 81, 2, 2, 328, 329, 7, 80, 2, 2, 329, 330,
-	7, 71, 2, 2, 330, 331, 3, 2, 2, 2, 331, 332, 8, 19, 3, 2, 332, 42, 3, 2,
-	2, 2, 333, 334, 7, 70, 2, 2, 334, 335, 7, 81, 2, 2, 335, 336, 7, 69, 2,
-	2, 336, 337, 7, 77, 2, 2, 337, 338, 7, 71, 2, 2, 338, 339, 7, 84, 2, 2,
-	339, 340, 7, 34, 2, 2, 340, 341, 7, 78, 2, 2, 341, 342, 7, 81, 2, 2, 342,
-	343, 7, 67, 2, 2, 343, 344, 7, 70, 2, 2, 344, 345, 3, 2, 2, 2, 345, 346,
-	8, 20, 3, 2, 346, 44, 3, 2, 2, 2, 347, 348, 7, 70, 2, 2, 348, 349, 7, 81,
-	2, 2, 349, 350, 7, 69, 2, 2, 350, 351, 7, 77, 2, 2, 351, 352, 7, 71, 2,
-	2, 352, 353, 7, 84, 2, 2, 353, 354, 7, 34, 2, 2, 354, 355, 7, 82, 2, 2,
-	355, 356, 7, 87, 2, 2, 356, 357, 7, 78, 2, 2, 357, 358, 7, 78, 2, 2, 358,
-	359, 3, 2, 2, 2, 359, 360, 8, 21, 3, 2, 360, 46, 3, 2, 2, 2, 361, 362,
-	7, 67, 2, 2, 362, 363, 7, 70, 2, 2, 363, 364, 7, 70, 2, 2, 364, 365, 3,
-	2, 2, 2, 365, 366, 8, 22, 3, 2, 366, 48, 3, 2, 2, 2, 367, 368, 7, 85, 2,
-	2, 368, 369, 7, 86, 2, 2, 369, 370, 7, 81, 2, 2, 370, 371, 7, 82, 2, 2,
-	371, 372, 7, 85, 2, 2, 372, 373, 7, 75, 2, 2, 373, 374, 7, 73, 2, 2, 374,
-	375, 7, 80, 2, 2, 375, 376, 7, 67, 2, 2, 376, 377, 7, 78, 2, 2, 377, 378,
-	3, 2, 2, 2, 378, 379, 8, 23, 3, 2, 379, 50, 3, 2, 2, 2, 380, 381, 7, 81,
-	2, 2, 381, 382, 7, 80, 2, 2, 382, 383, 7, 68, 2, 2, 383, 384, 7, 87, 2,
-	2, 384, 385, 7, 75, 2, 2, 385, 386, 7, 78, 2, 2, 386, 387, 7, 70, 2, 2,
-	387, 388, 3, 2, 2, 2, 388, 389, 8, 24, 3, 2, 389, 52, 3, 2, 2, 2, 390,
-	391, 7, 74, 2, 2, 391, 392, 7, 71, 2, 2, 392, 393, 7, 67, 2, 2, 393, 394,
-	7, 78, 2, 2, 394, 395, 7, 86, 2, 2, 395, 396, 7, 74, 2, 2, 396, 397, 7,
-	69, 2, 2, 397, 398, 7, 74, 2, 2, 398, 399, 7, 71, 2, 2, 399, 400, 7, 69,
-	2, 2, 400, 401, 7, 77, 2, 2, 401, 402, 3, 2, 2, 2, 402, 403, 8, 25, 3,
-	2, 403, 54, 3, 2, 2, 2, 404, 405, 7, 85, 2, 2, 405, 406, 7, 74, 2, 2, 406,
-	407, 7, 71, 2, 2, 407, 408, 7, 78, 2, 2, 408, 409, 7, 78, 2, 2, 409, 410,
-	3, 2, 2, 2, 410, 411, 8, 26, 3, 2, 411, 56, 3, 2, 2, 2, 412, 413, 7, 89,
-	2, 2, 413, 414, 7, 75, 2, 2, 414, 415, 7, 86, 2, 2, 415, 416, 7, 74, 2,
-	2, 416, 417, 7, 34, 2, 2, 417, 418, 7, 70, 2, 2, 418, 419, 7, 81, 2, 2,
-	419, 420, 7, 69, 2, 2, 420, 421, 7, 77, 2, 2, 421, 422, 7, 71, 2, 2, 422,
-	423, 7, 84, 2, 2, 423, 424, 3, 2, 2, 2, 424, 425, 8, 27, 3, 2, 425, 58,
-	3, 2, 2, 2, 426, 427, 7, 71, 2, 2, 427, 428, 7, 80, 2, 2, 428, 429, 7,
-	70, 2, 2, 429, 430, 3, 2, 2, 2, 430, 431, 8, 28, 3, 2, 431, 60, 3, 2, 2,
-	2, 432, 434, 9, 3, 2, 2, 433, 432, 3, 2, 2, 2, 434, 435, 3, 2, 2, 2, 435,
-	433, 3, 2, 2, 2, 435, 436, 3, 2, 2, 2, 436, 437, 3, 2, 2, 2, 437, 438,
-	8, 29, 3, 2, 438, 62, 3, 2, 2, 2, 439, 441, 5, 65, 31, 2, 440, 439, 3,
-	2, 2, 2, 440, 441, 3, 2, 2, 2, 441, 443, 3, 2, 2, 2, 442, 444, 5, 69, 33,
-	2, 443, 442, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 447, 3, 2, 2, 2, 445,
-	448, 7, 2, 2, 3, 446, 448, 5, 67, 32, 2, 447, 445, 3, 2, 2, 2, 447, 446,
-	3, 2, 2, 2, 448, 64, 3, 2, 2, 2, 449, 454, 9, 4, 2, 2, 450, 453, 9, 4,
-	2, 2, 451, 453, 5, 71, 34, 2, 452, 450, 3, 2, 2, 2, 452, 451, 3, 2, 2,
-	2, 453, 456, 3, 2, 2, 2, 454, 452, 3, 2, 2, 2, 454, 455, 3, 2, 2, 2, 455,
-	66, 3, 2, 2, 2, 456, 454, 3, 2, 2, 2, 457, 461, 9, 5, 2, 2, 458, 459, 7,
-	15, 2, 2, 459, 461, 7, 12, 2, 2, 460, 457, 3, 2, 2, 2, 460, 458, 3, 2,
-	2, 2, 461, 68, 3, 2, 2, 2, 462, 466, 7, 37, 2, 2, 463, 465, 10, 5, 2, 2,
-	464, 463, 3, 2, 2, 2, 465, 468, 3, 2, 2, 2, 466, 464, 3, 2, 2, 2, 466,
-	467, 3, 2, 2, 2, 467, 70, 3, 2, 2, 2, 468, 466, 3, 2, 2, 2, 469, 473, 7,
-	94, 2, 2, 470, 472, 9, 4, 2, 2, 471, 470, 3, 2, 2, 2, 472, 475, 3, 2, 2,
-	2, 473, 471, 3, 2, 2, 2, 473, 474, 3, 2, 2, 2, 474, 481, 3, 2, 2, 2, 475,
-	473, 3, 2, 2, 2, 476, 480, 9, 4, 2, 2, 477, 480, 5, 67, 32, 2, 478, 480,
-	5, 69, 33, 2, 479, 476, 3, 2, 2, 2, 479, 477, 3, 2, 2, 2, 479, 478, 3,
-	2, 2, 2, 480, 483, 3, 2, 2, 2, 481, 479, 3, 2, 2, 2, 481, 482, 3, 2, 2,
-	2, 482, 72, 3, 2, 2, 2, 483, 481, 3, 2, 2, 2, 484, 485, 5, 7, 2, 2, 485,
-	486, 3, 2, 2, 2, 486, 487, 8, 35, 6, 2, 487, 488, 8, 35, 2, 2, 488, 74,
-	3, 2, 2, 2, 489, 490, 5, 9, 3, 2, 490, 491, 3, 2, 2, 2, 491, 492, 8, 36,
-	7, 2, 492, 493, 8, 36, 3, 2, 493, 76, 3, 2, 2, 2, 494, 495, 5, 11, 4, 2,
-	495, 496, 3, 2, 2, 2, 496, 497, 8, 37, 8, 2, 497, 498, 8, 37, 3, 2, 498,
-	78, 3, 2, 2, 2, 499, 500, 5, 13, 5, 2, 500, 501, 3, 2, 2, 2, 501, 502,
-	8, 38, 9, 2, 502, 503, 8, 38, 3, 2, 503, 80, 3, 2, 2, 2, 504, 505, 5, 15,
-	6, 2, 505, 506, 3, 2, 2, 2, 506, 507, 8, 39, 10, 2, 507, 508, 8, 39, 3,
-	2, 508, 82, 3, 2, 2, 2, 509, 510, 5, 17, 7, 2, 510, 511, 3, 2, 2, 2, 511,
-	512, 8, 40, 11, 2, 512, 513, 8, 40, 3, 2, 513, 84, 3, 2, 2, 2, 514, 515,
-	5, 19, 8, 2, 515, 516, 3, 2, 2, 2, 516, 517, 8, 41, 12, 2, 517, 518, 8,
-	41, 3, 2, 518, 86, 3, 2, 2, 2, 519, 520, 5, 21, 9, 2, 520, 521, 3, 2, 2,
-	2, 521, 522, 8, 42, 13, 2, 522, 523, 8, 42, 3, 2, 523, 88, 3, 2, 2, 2,
-	524, 525, 5, 23, 10, 2, 525, 526, 3, 2, 2, 2, 526, 527, 8, 43, 14, 2, 527,
-	528, 8, 43, 3, 2, 528, 90, 3, 2, 2, 2, 529, 530, 5, 25, 11, 2, 530, 531,
-	3, 2, 2, 2, 531, 532, 8, 44, 15, 2, 532, 533, 8, 44, 4, 2, 533, 92, 3,
-	2, 2, 2, 534, 535, 5, 27, 12, 2, 535, 536, 3, 2, 2, 2, 536, 537, 8, 45,
-	16, 2, 537, 538, 8, 45, 4, 2, 538, 94, 3, 2, 2, 2, 539, 540, 5, 29, 13,
-	2, 540, 541, 3, 2, 2, 2, 541, 542, 8, 46, 17, 2, 542, 543, 8, 46, 5, 2,
-	543, 96, 3, 2, 2, 2, 544, 545, 5, 31, 14, 2, 545, 546, 3, 2, 2, 2, 546,
-	547, 8, 47, 18, 2, 547, 548, 8, 47, 3, 2, 548, 98, 3, 2, 2, 2, 549, 550,
-	5, 33, 15, 2, 550, 551, 3, 2, 2, 2, 551, 552, 8, 48, 19, 2, 552, 553, 8,
-	48, 3, 2, 553, 100, 3, 2, 2, 2, 554, 555, 5, 35, 16, 2, 555, 556, 3, 2,
-	2, 2, 556, 557, 8, 49, 20, 2, 557, 558, 8, 49, 3, 2, 558, 102, 3, 2, 2,
-	2, 559, 560, 5, 37, 17, 2, 560, 561, 3, 2, 2, 2, 561, 562, 8, 50, 21, 2,
-	562, 563, 8, 50, 3, 2, 563, 104, 3, 2, 2, 2, 564, 565, 5, 39, 18, 2, 565,
-	566, 3, 2, 2, 2, 566, 567, 8, 51, 22, 2, 567, 568, 8, 51, 3, 2, 568, 106,
-	3, 2, 2, 2, 569, 570, 5, 41, 19, 2, 570, 571, 3, 2, 2, 2, 571, 572, 8,
-	52, 23, 2, 572, 573, 8, 52, 3, 2, 573, 108, 3, 2, 2, 2, 574, 575, 5, 43,
-	20, 2, 575, 576, 3, 2, 2, 2, 576, 577, 8, 53, 24, 2, 577, 578, 8, 53, 3,
-	2, 578, 110, 3, 2, 2, 2, 579, 580, 5, 45, 21, 2, 580, 581, 3, 2, 2, 2,
-	581, 582, 8, 54, 25, 2, 582, 583, 8, 54, 3, 2, 583, 112, 3, 2, 2, 2, 584,
-	585, 5, 47, 22, 2, 585, 586, 3, 2, 2, 2, 586, 587, 8, 55, 26, 2, 587, 588,
-	8, 55, 3, 2, 588, 114, 3, 2, 2, 2, 589, 590, 5, 49, 23, 2, 590, 591, 3,
-	2, 2, 2, 591, 592, 8, 56, 27, 2, 592, 593, 8, 56, 3, 2, 593, 116, 3, 2,
-	2, 2, 594, 595, 5, 51, 24, 2, 595, 596, 3, 2, 2, 2, 596, 597, 8, 57, 28,
-	2, 597, 598, 8, 57, 3, 2, 598, 118, 3, 2, 2, 2, 599, 600, 5, 53, 25, 2,
-	600, 601, 3, 2, 2, 2, 601, 602, 8, 58, 29, 2, 602, 603, 8, 58, 3, 2, 603,
-	120, 3, 2, 2, 2, 604, 605, 5, 55, 26, 2, 605, 606, 3, 2, 2, 2, 606, 607,
-	8, 59, 30, 2, 607, 608, 8, 59, 3, 2, 608, 122, 3, 2, 2, 2, 609, 610, 5,
-	57, 27, 2, 610, 611, 3, 2, 2, 2, 611, 612, 8, 60, 31, 2, 612, 613, 8, 60,
-	3, 2, 613, 124, 3, 2, 2, 2, 614, 615, 5, 59, 28, 2, 615, 616, 3, 2, 2,
-	2, 616, 617, 8, 61, 32, 2, 617, 618, 8, 61, 3, 2, 618, 126, 3, 2, 2, 2,
-	619, 620, 5, 61, 29, 2, 620, 621, 3, 2, 2, 2, 621, 622, 8, 62, 33, 2, 622,
-	623, 8, 62, 3, 2, 623, 128, 3, 2, 2, 2, 624, 625, 5, 63, 30, 2, 625, 626,
-	3, 2, 2, 2, 626, 627, 8, 63, 34, 2, 627, 130, 3, 2, 2, 2, 628, 629, 5,
-	65, 31, 2, 629, 630, 3, 2, 2, 2, 630, 631, 8, 64, 35, 2, 631, 132, 3, 2,
-	2, 2, 632, 635, 5, 137, 67, 2, 633, 635, 5, 135, 66, 2, 634, 632, 3, 2,
-	2, 2, 634, 633, 3, 2, 2, 2, 635, 636, 3, 2, 2, 2, 636, 634, 3, 2, 2, 2,
-	636, 637, 3, 2, 2, 2, 637, 134, 3, 2, 2, 2, 638, 644, 7, 36, 2, 2, 639,
-	643, 10, 6, 2, 2, 640, 641, 7, 94, 2, 2, 641, 643, 7, 36, 2, 2, 642, 639,
-	3, 2, 2, 2, 642, 640, 3, 2, 2, 2, 643, 646, 3, 2, 2, 2, 644, 642, 3, 2,
-	2, 2, 644, 645, 3, 2, 2, 2, 645, 647, 3, 2, 2, 2, 646, 644, 3, 2, 2, 2,
-	647, 648, 7, 36, 2, 2, 648, 136, 3, 2, 2, 2, 649, 652, 10, 7, 2, 2, 650,
-	652, 5, 139, 68, 2, 651, 649, 3, 2, 2, 2, 651, 650, 3, 2, 2, 2, 652, 138,
-	3, 2, 2, 2, 653, 654, 7, 94, 2, 2, 654, 663, 11, 2, 2, 2, 655, 659, 5,
-	71, 34, 2, 656, 658, 9, 4, 2, 2, 657, 656, 3, 2, 2, 2, 658, 661, 3, 2,
-	2, 2, 659, 657, 3, 2, 2, 2, 659, 660, 3, 2, 2, 2, 660, 663, 3, 2, 2, 2,
-	661, 659, 3, 2, 2, 2, 662, 653, 3, 2, 2, 2, 662, 655, 3, 2, 2, 2, 663,
-	140, 3, 2, 2, 2, 664, 665, 5, 63, 30, 2, 665, 666, 3, 2, 2, 2, 666, 667,
-	8, 69, 34, 2, 667, 668, 8, 69, 36, 2, 668, 142, 3, 2, 2, 2, 669, 670, 5,
-	65, 31, 2, 670, 671, 3, 2, 2, 2, 671, 672, 8, 70, 35, 2, 672, 144, 3, 2,
-	2, 2, 673, 674, 7, 63, 2, 2, 674, 675, 3, 2, 2, 2, 675, 676, 8, 71, 37,
-	2, 676, 146, 3, 2, 2, 2, 677, 680, 5, 149, 73, 2, 678, 680, 5, 135, 66,
-	2, 679, 677, 3, 2, 2, 2, 679, 678, 3, 2, 2, 2, 680, 681, 3, 2, 2, 2, 681,
-	679, 3, 2, 2, 2, 681, 682, 3, 2, 2, 2, 682, 683, 3, 2, 2, 2, 683, 684,
-	8, 72, 38, 2, 684, 148, 3, 2, 2, 2, 685, 688, 10, 8, 2, 2, 686, 688, 5,
-	139, 68, 2, 687, 685, 3, 2, 2, 2, 687, 686, 3, 2, 2, 2, 688, 150, 3, 2,
-	2, 2, 689, 690, 5, 63, 30, 2, 690, 691, 3, 2, 2, 2, 691, 692, 8, 74, 34,
-	2, 692, 693, 8, 74, 36, 2, 693, 152, 3, 2, 2, 2, 694, 695, 5, 65, 31, 2,
-	695, 696, 3, 2, 2, 2, 696, 697, 8, 75, 35, 2, 697, 154, 3, 2, 2, 2, 698,
-	699, 7, 63, 2, 2, 699, 700, 3, 2, 2, 2, 700, 701, 8, 76, 39, 2, 701, 156,
-	3, 2, 2, 2, 702, 703, 5, 147, 72, 2, 703, 704, 3, 2, 2, 2, 704, 705, 8,
-	77, 38, 2, 705, 158, 3, 2, 2, 2, 706, 707, 5, 151, 74, 2, 707, 708, 3,
-	2, 2, 2, 708, 709, 8, 78, 34, 2, 709, 710, 8, 78, 36, 2, 710, 160, 3, 2,
-	2, 2, 711, 712, 5, 153, 75, 2, 712, 713, 3, 2, 2, 2, 713, 714, 8, 79, 35,
-	2, 714, 162, 3, 2, 2, 2, 30, 2, 3, 4, 5, 6, 164, 166, 435, 440, 443, 447,
-	452, 454, 460, 466, 473, 479, 481, 634, 636, 642, 644, 651, 659, 662, 679,
-	681, 687, 40, 7, 3, 2, 7, 4, 2, 7, 5, 2, 7, 6, 2, 9, 5, 2, 9, 6, 2, 9,
-	7, 2, 9, 8, 2, 9, 9, 2, 9, 10, 2, 9, 11, 2, 9, 12, 2, 9, 13, 2, 9, 14,
-	2, 9, 15, 2, 9, 16, 2, 9, 17, 2, 9, 18, 2, 9, 19, 2, 9, 20, 2, 9, 21, 2,
-	9, 22, 2, 9, 23, 2, 9, 24, 2, 9, 25, 2, 9, 26, 2, 9, 27, 2, 9, 28, 2, 9,
-	29, 2, 9, 30, 2, 9, 31, 2, 9, 32, 2, 9, 33, 2, 9, 34, 2, 6, 2, 2, 4, 4,
-	2, 9, 35, 2, 9, 36, 2,
+	23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24,
+	3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3,
+	25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26,
+	3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3,
+	27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28,
+	3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3,
+	29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 31,
+	3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
+	32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34,
+	3, 34, 3, 34, 3, 34, 3, 35, 6, 35, 501, 10, 35, 13, 35, 14, 35, 502, 3,
+	35, 3, 35, 3, 36, 5, 36, 508, 10, 36, 3, 36, 5, 36, 511, 10, 36, 3, 36,
+	3, 36, 5, 36, 515, 10, 36, 3, 37, 3, 37, 3, 37, 7, 37, 520, 10, 37, 12,
+	37, 14, 37, 523, 11, 37, 3, 38, 3, 38, 3, 38, 5, 38, 528, 10, 38, 3, 39,
+	3, 39, 7, 39, 532, 10, 39, 12, 39, 14, 39, 535, 11, 39, 3, 40, 3, 40, 7,
+	40, 539, 10, 40, 12, 40, 14, 40, 542, 11, 40, 3, 40, 3, 40, 3, 40, 7, 40,
+	547, 10, 40, 12, 40, 14, 40, 550, 11, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3,
+	41, 3, 42, 3, 42, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43,
+	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3,
+	46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48,
+	3, 48, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3,
+	50, 3, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52,
+	3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3,
+	54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56,
+	3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3,
+	58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60,
+	3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 62, 3,
+	62, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
+	3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
+	67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69,
+	3, 69, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 71, 3,
+	71, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73,
+	3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3, 77, 3, 77, 6, 77, 732, 10, 77,
+	13, 77, 14, 77, 733, 3, 78, 3, 78, 3, 78, 3, 78, 7, 78, 740, 10, 78, 12,
+	78, 14, 78, 743, 11, 78, 3, 78, 3, 78, 3, 79, 3, 79, 5, 79, 749, 10, 79,
+	3, 80, 3, 80, 3, 80, 3, 80, 7, 80, 755, 10, 80, 12, 80, 14, 80, 758, 11,
+	80, 5, 80, 760, 10, 80, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 82, 3, 82,
+	3, 82, 3, 82, 3, 83, 3, 83, 3, 83, 3, 83, 3, 84, 3, 84, 6, 84, 777, 10,
+	84, 13, 84, 14, 84, 778, 3, 84, 3, 84, 3, 85, 3, 85, 5, 85, 785, 10, 85,
+	3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 87, 3, 87, 3, 87, 3, 87, 3, 88, 3,
+	88, 3, 88, 3, 88, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90,
+	3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 2, 2, 92, 7, 5, 9, 6, 11, 7, 13, 8,
+	15, 9, 17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17,
+	33, 18, 35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26,
+	51, 27, 53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35,
+	69, 36, 71, 37, 73, 38, 75, 39, 77, 40, 79, 2, 81, 2, 83, 2, 85, 2, 87,
+	2, 89, 2, 91, 2, 93, 2, 95, 2, 97, 2, 99, 2, 101, 2, 103, 2, 105, 2, 107,
+	2, 109, 2, 111, 2, 113, 2, 115, 2, 117, 2, 119, 2, 121, 2, 123, 2, 125,
+	2, 127, 2, 129, 2, 131, 2, 133, 2, 135, 2, 137, 2, 139, 2, 141, 2, 143,
+	2, 145, 2, 147, 2, 149, 2, 151, 2, 153, 2, 155, 2, 157, 41, 159, 2, 161,
+	2, 163, 2, 165, 2, 167, 2, 169, 42, 171, 2, 173, 2, 175, 2, 177, 2, 179,
+	2, 181, 2, 183, 2, 185, 2, 7, 2, 3, 4, 5, 6, 9, 6, 2, 47, 48, 50, 59, 67,
+	92, 99, 124, 3, 2, 67, 92, 4, 2, 11, 11, 34, 34, 4, 2, 12, 12, 15, 15,
+	3, 2, 36, 36, 7, 2, 11, 12, 15, 15, 34, 34, 36, 36, 94, 94, 7, 2, 11, 12,
+	15, 15, 34, 34, 36, 36, 63, 63, 2, 823, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2,
+	2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2,
+	2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3,
+	2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33,
+	3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2,
+	41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2,
+	2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2,
+	2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2,
+	2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3,
+	2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 3, 85,
+	3, 2, 2, 2, 3, 87, 3, 2, 2, 2, 3, 89, 3, 2, 2, 2, 3, 91, 3, 2, 2, 2, 3,
+	93, 3, 2, 2, 2, 3, 95, 3, 2, 2, 2, 3, 97, 3, 2, 2, 2, 3, 99, 3, 2, 2, 2,
+	3, 101, 3, 2, 2, 2, 3, 103, 3, 2, 2, 2, 3, 105, 3, 2, 2, 2, 3, 107, 3,
+	2, 2, 2, 3, 109, 3, 2, 2, 2, 3, 111, 3, 2, 2, 2, 3, 113, 3, 2, 2, 2, 3,
+	115, 3, 2, 2, 2, 3, 117, 3, 2, 2, 2, 3, 119, 3, 2, 2, 2, 3, 121, 3, 2,
+	2, 2, 3, 123, 3, 2, 2, 2, 3, 125, 3, 2, 2, 2, 3, 127, 3, 2, 2, 2, 3, 129,
+	3, 2, 2, 2, 3, 131, 3, 2, 2, 2, 3, 133, 3, 2, 2, 2, 3, 135, 3, 2, 2, 2,
+	3, 137, 3, 2, 2, 2, 3, 139, 3, 2, 2, 2, 3, 141, 3, 2, 2, 2, 3, 143, 3,
+	2, 2, 2, 3, 145, 3, 2, 2, 2, 3, 147, 3, 2, 2, 2, 3, 149, 3, 2, 2, 2, 3,
+	151, 3, 2, 2, 2, 3, 153, 3, 2, 2, 2, 3, 155, 3, 2, 2, 2, 4, 157, 3, 2,
+	2, 2, 4, 165, 3, 2, 2, 2, 4, 167, 3, 2, 2, 2, 5, 169, 3, 2, 2, 2, 5, 171,
+	3, 2, 2, 2, 5, 175, 3, 2, 2, 2, 5, 177, 3, 2, 2, 2, 6, 179, 3, 2, 2, 2,
+	6, 181, 3, 2, 2, 2, 6, 183, 3, 2, 2, 2, 6, 185, 3, 2, 2, 2, 7, 188, 3,
+	2, 2, 2, 9, 196, 3, 2, 2, 2, 11, 203, 3, 2, 2, 2, 13, 221, 3, 2, 2, 2,
+	15, 228, 3, 2, 2, 2, 17, 244, 3, 2, 2, 2, 19, 257, 3, 2, 2, 2, 21, 263,
+	3, 2, 2, 2, 23, 272, 3, 2, 2, 2, 25, 281, 3, 2, 2, 2, 27, 287, 3, 2, 2,
+	2, 29, 293, 3, 2, 2, 2, 31, 301, 3, 2, 2, 2, 33, 309, 3, 2, 2, 2, 35, 319,
+	3, 2, 2, 2, 37, 326, 3, 2, 2, 2, 39, 332, 3, 2, 2, 2, 41, 345, 3, 2, 2,
+	2, 43, 357, 3, 2, 2, 2, 45, 371, 3, 2, 2, 2, 47, 385, 3, 2, 2, 2, 49, 391,
+	3, 2, 2, 2, 51, 404, 3, 2, 2, 2, 53, 414, 3, 2, 2, 2, 55, 428, 3, 2, 2,
+	2, 57, 436, 3, 2, 2, 2, 59, 450, 3, 2, 2, 2, 61, 456, 3, 2, 2, 2, 63, 466,
+	3, 2, 2, 2, 65, 471, 3, 2, 2, 2, 67, 476, 3, 2, 2, 2, 69, 486, 3, 2, 2,
+	2, 71, 493, 3, 2, 2, 2, 73, 500, 3, 2, 2, 2, 75, 507, 3, 2, 2, 2, 77, 516,
+	3, 2, 2, 2, 79, 527, 3, 2, 2, 2, 81, 529, 3, 2, 2, 2, 83, 536, 3, 2, 2,
+	2, 85, 551, 3, 2, 2, 2, 87, 556, 3, 2, 2, 2, 89, 561, 3, 2, 2, 2, 91, 566,
+	3, 2, 2, 2, 93, 571, 3, 2, 2, 2, 95, 576, 3, 2, 2, 2, 97, 581, 3, 2, 2,
+	2, 99, 586, 3, 2, 2, 2, 101, 591, 3, 2, 2, 2, 103, 596, 3, 2, 2, 2, 105,
+	601, 3, 2, 2, 2, 107, 606, 3, 2, 2, 2, 109, 611, 3, 2, 2, 2, 111, 616,
+	3, 2, 2, 2, 113, 621, 3, 2, 2, 2, 115, 626, 3, 2, 2, 2, 117, 631, 3, 2,
+	2, 2, 119, 636, 3, 2, 2, 2, 121, 641, 3, 2, 2, 2, 123, 646, 3, 2, 2, 2,
+	125, 651, 3, 2, 2, 2, 127, 656, 3, 2, 2, 2, 129, 661, 3, 2, 2, 2, 131,
+	666, 3, 2, 2, 2, 133, 671, 3, 2, 2, 2, 135, 676, 3, 2, 2, 2, 137, 681,
+	3, 2, 2, 2, 139, 686, 3, 2, 2, 2, 141, 691, 3, 2, 2, 2, 143, 696, 3, 2,
+	2, 2, 145, 701, 3, 2, 2, 2, 147, 706, 3, 2, 2, 2, 149, 711, 3, 2, 2, 2,
+	151, 716, 3, 2, 2, 2, 153, 721, 3, 2, 2, 2, 155, 725, 3, 2, 2, 2, 157,
+	731, 3, 2, 2, 2, 159, 735, 3, 2, 2, 2, 161, 748, 3, 2, 2, 2, 163, 759,
+	3, 2, 2, 2, 165, 761, 3, 2, 2, 2, 167, 766, 3, 2, 2, 2, 169, 770, 3, 2,
+	2, 2, 171, 776, 3, 2, 2, 2, 173, 784, 3, 2, 2, 2, 175, 786, 3, 2, 2, 2,
+	177, 791, 3, 2, 2, 2, 179, 795, 3, 2, 2, 2, 181, 799, 3, 2, 2, 2, 183,
+	803, 3, 2, 2, 2, 185, 808, 3, 2, 2, 2, 187, 189, 9, 2, 2, 2, 188, 187,
+	3, 2, 2, 2, 189, 190, 3, 2, 2, 2, 190, 188, 3, 2, 2, 2, 190, 191, 3, 2,
+	2, 2, 191, 192, 3, 2, 2, 2, 192, 193, 7, 60, 2, 2, 193, 194, 3, 2, 2, 2,
+	194, 195, 8, 2, 2, 2, 195, 8, 3, 2, 2, 2, 196, 197, 7, 72, 2, 2, 197, 198,
+	7, 84, 2, 2, 198, 199, 7, 81, 2, 2, 199, 200, 7, 79, 2, 2, 200, 201, 3,
+	2, 2, 2, 201, 202, 8, 3, 3, 2, 202, 10, 3, 2, 2, 2, 203, 204, 7, 72, 2,
+	2, 204, 205, 7, 84, 2, 2, 205, 206, 7, 81, 2, 2, 206, 207, 7, 79, 2, 2,
+	207, 208, 7, 34, 2, 2, 208, 209, 7, 70, 2, 2, 209, 210, 7, 81, 2, 2, 210,
+	211, 7, 69, 2, 2, 211, 212, 7, 77, 2, 2, 212, 213, 7, 71, 2, 2, 213, 214,
+	7, 84, 2, 2, 214, 215, 7, 72, 2, 2, 215, 216, 7, 75, 2, 2, 216, 217, 7,
+	78, 2, 2, 217, 218, 7, 71, 2, 2, 218, 219, 3, 2, 2, 2, 219, 220, 8, 4,
+	3, 2, 220, 12, 3, 2, 2, 2, 221, 222, 7, 69, 2, 2, 222, 223, 7, 81, 2, 2,
+	223, 224, 7, 82, 2, 2, 224, 225, 7, 91, 2, 2, 225, 226, 3, 2, 2, 2, 226,
+	227, 8, 5, 3, 2, 227, 14, 3, 2, 2, 2, 228, 229, 7, 85, 2, 2, 229, 230,
+	7, 67, 2, 2, 230, 231, 7, 88, 2, 2, 231, 232, 7, 71, 2, 2, 232, 233, 7,
+	34, 2, 2, 233, 234, 7, 67, 2, 2, 234, 235, 7, 84, 2, 2, 235, 236, 7, 86,
+	2, 2, 236, 237, 7, 75, 2, 2, 237, 238, 7, 72, 2, 2, 238, 239, 7, 67, 2,
+	2, 239, 240, 7, 69, 2, 2, 240, 241, 7, 86, 2, 2, 241, 242, 3, 2, 2, 2,
+	242, 243, 8, 6, 3, 2, 243, 16, 3, 2, 2, 2, 244, 245, 7, 85, 2, 2, 245,
+	246, 7, 67, 2, 2, 246, 247, 7, 88, 2, 2, 247, 248, 7, 71, 2, 2, 248, 249,
+	7, 34, 2, 2, 249, 250, 7, 75, 2, 2, 250, 251, 7, 79, 2, 2, 251, 252, 7,
+	67, 2, 2, 252, 253, 7, 73, 2, 2, 253, 254, 7, 71, 2, 2, 254, 255, 3, 2,
+	2, 2, 255, 256, 8, 7, 3, 2, 256, 18, 3, 2, 2, 2, 257, 258, 7, 84, 2, 2,
+	258, 259, 7, 87, 2, 2, 259, 260, 7, 80, 2, 2, 260, 261, 3, 2, 2, 2, 261,
+	262, 8, 8, 3, 2, 262, 20, 3, 2, 2, 2, 263, 264, 7, 71, 2, 2, 264, 265,
+	7, 90, 2, 2, 265, 266, 7, 82, 2, 2, 266, 267, 7, 81, 2, 2, 267, 268, 7,
+	85, 2, 2, 268, 269, 7, 71, 2, 2, 269, 270, 3, 2, 2, 2, 270, 271, 8, 9,
+	3, 2, 271, 22, 3, 2, 2, 2, 272, 273, 7, 88, 2, 2, 273, 274, 7, 81, 2, 2,
+	274, 275, 7, 78, 2, 2, 275, 276, 7, 87, 2, 2, 276, 277, 7, 79, 2, 2, 277,
+	278, 7, 71, 2, 2, 278, 279, 3, 2, 2, 2, 279, 280, 8, 10, 3, 2, 280, 24,
+	3, 2, 2, 2, 281, 282, 7, 71, 2, 2, 282, 283, 7, 80, 2, 2, 283, 284, 7,
+	88, 2, 2, 284, 285, 3, 2, 2, 2, 285, 286, 8, 11, 4, 2, 286, 26, 3, 2, 2,
+	2, 287, 288, 7, 67, 2, 2, 288, 289, 7, 84, 2, 2, 289, 290, 7, 73, 2, 2,
+	290, 291, 3, 2, 2, 2, 291, 292, 8, 12, 4, 2, 292, 28, 3, 2, 2, 2, 293,
+	294, 7, 78, 2, 2, 294, 295, 7, 67, 2, 2, 295, 296, 7, 68, 2, 2, 296, 297,
+	7, 71, 2, 2, 297, 298, 7, 78, 2, 2, 298, 299, 3, 2, 2, 2, 299, 300, 8,
+	13, 5, 2, 300, 30, 3, 2, 2, 2, 301, 302, 7, 68, 2, 2, 302, 303, 7, 87,
+	2, 2, 303, 304, 7, 75, 2, 2, 304, 305, 7, 78, 2, 2, 305, 306, 7, 70, 2,
+	2, 306, 307, 3, 2, 2, 2, 307, 308, 8, 14, 3, 2, 308, 32, 3, 2, 2, 2, 309,
+	310, 7, 89, 2, 2, 310, 311, 7, 81, 2, 2, 311, 312, 7, 84, 2, 2, 312, 313,
+	7, 77, 2, 2, 313, 314, 7, 70, 2, 2, 314, 315, 7, 75, 2, 2, 315, 316, 7,
+	84, 2, 2, 316, 317, 3, 2, 2, 2, 317, 318, 8, 15, 3, 2, 318, 34, 3, 2, 2,
+	2, 319, 320, 7, 87, 2, 2, 320, 321, 7, 85, 2, 2, 321, 322, 7, 71, 2, 2,
+	322, 323, 7, 84, 2, 2, 323, 324, 3, 2, 2, 2, 324, 325, 8, 16, 3, 2, 325,
+	36, 3, 2, 2, 2, 326, 327, 7, 69, 2, 2, 327, 328, 7, 79, 2, 2, 328, 329,
+	7, 70, 2, 2, 329, 330, 3, 2, 2, 2, 330, 331, 8, 17, 3, 2, 331, 38, 3, 2,
+	2, 2, 332, 333, 7, 71, 2, 2, 333, 334, 7, 80, 2, 2, 334, 335, 7, 86, 2,
+	2, 335, 336, 7, 84, 2, 2, 336, 337, 7, 91, 2, 2, 337, 338, 7, 82, 2, 2,
+	338, 339, 7, 81, 2, 2, 339, 340, 7, 75, 2, 2, 340, 341, 7, 80, 2, 2, 341,
+	342, 7, 86, 2, 2, 342, 343, 3, 2, 2, 2, 343, 344, 8, 18, 3, 2, 344, 40,
+	3, 2, 2, 2, 345, 346, 7, 73, 2, 2, 346, 347, 7, 75, 2, 2, 347, 348, 7,
+	86, 2, 2, 348, 349, 7, 34, 2, 2, 349, 350, 7, 69, 2, 2, 350, 351, 7, 78,
+	2, 2, 351, 352, 7, 81, 2, 2, 352, 353, 7, 80, 2, 2, 353, 354, 7, 71, 2,
+	2, 354, 355, 3, 2, 2, 2, 355, 356, 8, 19, 3, 2, 356, 42, 3, 2, 2, 2, 357,
+	358, 7, 70, 2, 2, 358, 359, 7, 81, 2, 2, 359, 360, 7, 69, 2, 2, 360, 361,
+	7, 77, 2, 2, 361, 362, 7, 71, 2, 2, 362, 363, 7, 84, 2, 2, 363, 364, 7,
+	34, 2, 2, 364, 365, 7, 78, 2, 2, 365, 366, 7, 81, 2, 2, 366, 367, 7, 67,
+	2, 2, 367, 368, 7, 70, 2, 2, 368, 369, 3, 2, 2, 2, 369, 370, 8, 20, 3,
+	2, 370, 44, 3, 2, 2, 2, 371, 372, 7, 70, 2, 2, 372, 373, 7, 81, 2, 2, 373,
+	374, 7, 69, 2, 2, 374, 375, 7, 77, 2, 2, 375, 376, 7, 71, 2, 2, 376, 377,
+	7, 84, 2, 2, 377, 378, 7, 34, 2, 2, 378, 379, 7, 82, 2, 2, 379, 380, 7,
+	87, 2, 2, 380, 381, 7, 78, 2, 2, 381, 382, 7, 78, 2, 2, 382, 383, 3, 2,
+	2, 2, 383, 384, 8, 21, 3, 2, 384, 46, 3, 2, 2, 2, 385, 386, 7, 67, 2, 2,
+	386, 387, 7, 70, 2, 2, 387, 388, 7, 70, 2, 2, 388, 389, 3, 2, 2, 2, 389,
+	390, 8, 22, 3, 2, 390, 48, 3, 2, 2, 2, 391, 392, 7, 85, 2, 2, 392, 393,
+	7, 86, 2, 2, 393, 394, 7, 81, 2, 2, 394, 395, 7, 82, 2, 2, 395, 396, 7,
+	85, 2, 2, 396, 397, 7, 75, 2, 2, 397, 398, 7, 73, 2, 2, 398, 399, 7, 80,
+	2, 2, 399, 400, 7, 67, 2, 2, 400, 401, 7, 78, 2, 2, 401, 402, 3, 2, 2,
+	2, 402, 403, 8, 23, 3, 2, 403, 50, 3, 2, 2, 2, 404, 405, 7, 81, 2, 2, 405,
+	406, 7, 80, 2, 2, 406, 407, 7, 68, 2, 2, 407, 408, 7, 87, 2, 2, 408, 409,
+	7, 75, 2, 2, 409, 410, 7, 78, 2, 2, 410, 411, 7, 70, 2, 2, 411, 412, 3,
+	2, 2, 2, 412, 413, 8, 24, 3, 2, 413, 52, 3, 2, 2, 2, 414, 415, 7, 74, 2,
+	2, 415, 416, 7, 71, 2, 2, 416, 417, 7, 67, 2, 2, 417, 418, 7, 78, 2, 2,
+	418, 419, 7, 86, 2, 2, 419, 420, 7, 74, 2, 2, 420, 421, 7, 69, 2, 2, 421,
+	422, 7, 74, 2, 2, 422, 423, 7, 71, 2, 2, 423, 424, 7, 69, 2, 2, 424, 425,
+	7, 77, 2, 2, 425, 426, 3, 2, 2, 2, 426, 427, 8, 25, 3, 2, 427, 54, 3, 2,
+	2, 2, 428, 429, 7, 85, 2, 2, 429, 430, 7, 74, 2, 2, 430, 431, 7, 71, 2,
+	2, 431, 432, 7, 78, 2, 2, 432, 433, 7, 78, 2, 2, 433, 434, 3, 2, 2, 2,
+	434, 435, 8, 26, 3, 2, 435, 56, 3, 2, 2, 2, 436, 437, 7, 89, 2, 2, 437,
+	438, 7, 75, 2, 2, 438, 439, 7, 86, 2, 2, 439, 440, 7, 74, 2, 2, 440, 441,
+	7, 34, 2, 2, 441, 442, 7, 70, 2, 2, 442, 443, 7, 81, 2, 2, 443, 444, 7,
+	69, 2, 2, 444, 445, 7, 77, 2, 2, 445, 446, 7, 71, 2, 2, 446, 447, 7, 84,
+	2, 2, 447, 448, 3, 2, 2, 2, 448, 449, 8, 27, 3, 2, 449, 58, 3, 2, 2, 2,
+	450, 451, 7, 71, 2, 2, 451, 452, 7, 80, 2, 2, 452, 453, 7, 70, 2, 2, 453,
+	454, 3, 2, 2, 2, 454, 455, 8, 28, 3, 2, 455, 60, 3, 2, 2, 2, 456, 457,
+	7, 69, 2, 2, 457, 458, 7, 81, 2, 2, 458, 459, 7, 79, 2, 2, 459, 460, 7,
+	79, 2, 2, 460, 461, 7, 67, 2, 2, 461, 462, 7, 80, 2, 2, 462, 463, 7, 70,
+	2, 2, 463, 464, 3, 2, 2, 2, 464, 465, 8, 29, 3, 2, 465, 62, 3, 2, 2, 2,
+	466, 467, 7, 70, 2, 2, 467, 468, 7, 81, 2, 2, 468, 469, 3, 2, 2, 2, 469,
+	470, 8, 30, 3, 2, 470, 64, 3, 2, 2, 2, 471, 472, 7, 75, 2, 2, 472, 473,
+	7, 72, 2, 2, 473, 474, 3, 2, 2, 2, 474, 475, 8, 31, 3, 2, 475, 66, 3, 2,
+	2, 2, 476, 477, 7, 71, 2, 2, 477, 478, 7, 78, 2, 2, 478, 479, 7, 85, 2,
+	2, 479, 480, 7, 71, 2, 2, 480, 481, 7, 34, 2, 2, 481, 482, 7, 75, 2, 2,
+	482, 483, 7, 72, 2, 2, 483, 484, 3, 2, 2, 2, 484, 485, 8, 32, 3, 2, 485,
+	68, 3, 2, 2, 2, 486, 487, 7, 71, 2, 2, 487, 488, 7, 78, 2, 2, 488, 489,
+	7, 85, 2, 2, 489, 490, 7, 71, 2, 2, 490, 491, 3, 2, 2, 2, 491, 492, 8,
+	33, 3, 2, 492, 70, 3, 2, 2, 2, 493, 494, 7, 72, 2, 2, 494, 495, 7, 81,
+	2, 2, 495, 496, 7, 84, 2, 2, 496, 497, 3, 2, 2, 2, 497, 498, 8, 34, 3,
+	2, 498, 72, 3, 2, 2, 2, 499, 501, 9, 3, 2, 2, 500, 499, 3, 2, 2, 2, 501,
+	502, 3, 2, 2, 2, 502, 500, 3, 2, 2, 2, 502, 503, 3, 2, 2, 2, 503, 504,
+	3, 2, 2, 2, 504, 505, 8, 35, 3, 2, 505, 74, 3, 2, 2, 2, 506, 508, 5, 77,
+	37, 2, 507, 506, 3, 2, 2, 2, 507, 508, 3, 2, 2, 2, 508, 510, 3, 2, 2, 2,
+	509, 511, 5, 81, 39, 2, 510, 509, 3, 2, 2, 2, 510, 511, 3, 2, 2, 2, 511,
+	514, 3, 2, 2, 2, 512, 515, 7, 2, 2, 3, 513, 515, 5, 79, 38, 2, 514, 512,
+	3, 2, 2, 2, 514, 513, 3, 2, 2, 2, 515, 76, 3, 2, 2, 2, 516, 521, 9, 4,
+	2, 2, 517, 520, 9, 4, 2, 2, 518, 520, 5, 83, 40, 2, 519, 517, 3, 2, 2,
+	2, 519, 518, 3, 2, 2, 2, 520, 523, 3, 2, 2, 2, 521, 519, 3, 2, 2, 2, 521,
+	522, 3, 2, 2, 2, 522, 78, 3, 2, 2, 2, 523, 521, 3, 2, 2, 2, 524, 528, 9,
+	5, 2, 2, 525, 526, 7, 15, 2, 2, 526, 528, 7, 12, 2, 2, 527, 524, 3, 2,
+	2, 2, 527, 525, 3, 2, 2, 2, 528, 80, 3, 2, 2, 2, 529, 533, 7, 37, 2, 2,
+	530, 532, 10, 5, 2, 2, 531, 530, 3, 2, 2, 2, 532, 535, 3, 2, 2, 2, 533,
+	531, 3, 2, 2, 2, 533, 534, 3, 2, 2, 2, 534, 82, 3, 2, 2, 2, 535, 533, 3,
+	2, 2, 2, 536, 540, 7, 94, 2, 2, 537, 539, 9, 4, 2, 2, 538, 537, 3, 2, 2,
+	2, 539, 542, 3, 2, 2, 2, 540, 538, 3, 2, 2, 2, 540, 541, 3, 2, 2, 2, 541,
+	548, 3, 2, 2, 2, 542, 540, 3, 2, 2, 2, 543, 547, 9, 4, 2, 2, 544, 547,
+	5, 79, 38, 2, 545, 547, 5, 81, 39, 2, 546, 543, 3, 2, 2, 2, 546, 544, 3,
+	2, 2, 2, 546, 545, 3, 2, 2, 2, 547, 550, 3, 2, 2, 2, 548, 546, 3, 2, 2,
+	2, 548, 549, 3, 2, 2, 2, 549, 84, 3, 2, 2, 2, 550, 548, 3, 2, 2, 2, 551,
+	552, 5, 7, 2, 2, 552, 553, 3, 2, 2, 2, 553, 554, 8, 41, 6, 2, 554, 555,
+	8, 41, 2, 2, 555, 86, 3, 2, 2, 2, 556, 557, 5, 9, 3, 2, 557, 558, 3, 2,
+	2, 2, 558, 559, 8, 42, 7, 2, 559, 560, 8, 42, 3, 2, 560, 88, 3, 2, 2, 2,
+	561, 562, 5, 11, 4, 2, 562, 563, 3, 2, 2, 2, 563, 564, 8, 43, 8, 2, 564,
+	565, 8, 43, 3, 2, 565, 90, 3, 2, 2, 2, 566, 567, 5, 13, 5, 2, 567, 568,
+	3, 2, 2, 2, 568, 569, 8, 44, 9, 2, 569, 570, 8, 44, 3, 2, 570, 92, 3, 2,
+	2, 2, 571, 572, 5, 15, 6, 2, 572, 573, 3, 2, 2, 2, 573, 574, 8, 45, 10,
+	2, 574, 575, 8, 45, 3, 2, 575, 94, 3, 2, 2, 2, 576, 577, 5, 17, 7, 2, 577,
+	578, 3, 2, 2, 2, 578, 579, 8, 46, 11, 2, 579, 580, 8, 46, 3, 2, 580, 96,
+	3, 2, 2, 2, 581, 582, 5, 19, 8, 2, 582, 583, 3, 2, 2, 2, 583, 584, 8, 47,
+	12, 2, 584, 585, 8, 47, 3, 2, 585, 98, 3, 2, 2, 2, 586, 587, 5, 21, 9,
+	2, 587, 588, 3, 2, 2, 2, 588, 589, 8, 48, 13, 2, 589, 590, 8, 48, 3, 2,
+	590, 100, 3, 2, 2, 2, 591, 592, 5, 23, 10, 2, 592, 593, 3, 2, 2, 2, 593,
+	594, 8, 49, 14, 2, 594, 595, 8, 49, 3, 2, 595, 102, 3, 2, 2, 2, 596, 597,
+	5, 25, 11, 2, 597, 598, 3, 2, 2, 2, 598, 599, 8, 50, 15, 2, 599, 600, 8,
+	50, 4, 2, 600, 104, 3, 2, 2, 2, 601, 602, 5, 27, 12, 2, 602, 603, 3, 2,
+	2, 2, 603, 604, 8, 51, 16, 2, 604, 605, 8, 51, 4, 2, 605, 106, 3, 2, 2,
+	2, 606, 607, 5, 29, 13, 2, 607, 608, 3, 2, 2, 2, 608, 609, 8, 52, 17, 2,
+	609, 610, 8, 52, 5, 2, 610, 108, 3, 2, 2, 2, 611, 612, 5, 31, 14, 2, 612,
+	613, 3, 2, 2, 2, 613, 614, 8, 53, 18, 2, 614, 615, 8, 53, 3, 2, 615, 110,
+	3, 2, 2, 2, 616, 617, 5, 33, 15, 2, 617, 618, 3, 2, 2, 2, 618, 619, 8,
+	54, 19, 2, 619, 620, 8, 54, 3, 2, 620, 112, 3, 2, 2, 2, 621, 622, 5, 35,
+	16, 2, 622, 623, 3, 2, 2, 2, 623, 624, 8, 55, 20, 2, 624, 625, 8, 55, 3,
+	2, 625, 114, 3, 2, 2, 2, 626, 627, 5, 37, 17, 2, 627, 628, 3, 2, 2, 2,
+	628, 629, 8, 56, 21, 2, 629, 630, 8, 56, 3, 2, 630, 116, 3, 2, 2, 2, 631,
+	632, 5, 39, 18, 2, 632, 633, 3, 2, 2, 2, 633, 634, 8, 57, 22, 2, 634, 635,
+	8, 57, 3, 2, 635, 118, 3, 2, 2, 2, 636, 637, 5, 41, 19, 2, 637, 638, 3,
+	2, 2, 2, 638, 639, 8, 58, 23, 2, 639, 640, 8, 58, 3, 2, 640, 120, 3, 2,
+	2, 2, 641, 642, 5, 43, 20, 2, 642, 643, 3, 2, 2, 2, 643, 644, 8, 59, 24,
+	2, 644, 645, 8, 59, 3, 2, 645, 122, 3, 2, 2, 2, 646, 647, 5, 45, 21, 2,
+	647, 648, 3, 2, 2, 2, 648, 649, 8, 60, 25, 2, 649, 650, 8, 60, 3, 2, 650,
+	124, 3, 2, 2, 2, 651, 652, 5, 47, 22, 2, 652, 653, 3, 2, 2, 2, 653, 654,
+	8, 61, 26, 2, 654, 655, 8, 61, 3, 2, 655, 126, 3, 2, 2, 2, 656, 657, 5,
+	49, 23, 2, 657, 658, 3, 2, 2, 2, 658, 659, 8, 62, 27, 2, 659, 660, 8, 62,
+	3, 2, 660, 128, 3, 2, 2, 2, 661, 662, 5, 51, 24, 2, 662, 663, 3, 2, 2,
+	2, 663, 664, 8, 63, 28, 2, 664, 665, 8, 63, 3, 2, 665, 130, 3, 2, 2, 2,
+	666, 667, 5, 53, 25, 2, 667, 668, 3, 2, 2, 2, 668, 669, 8, 64, 29, 2, 669,
+	670, 8, 64, 3, 2, 670, 132, 3, 2, 2, 2, 671, 672, 5, 55, 26, 2, 672, 673,
+	3, 2, 2, 2, 673, 674, 8, 65, 30, 2, 674, 675, 8, 65, 3, 2, 675, 134, 3,
+	2, 2, 2, 676, 677, 5, 57, 27, 2, 677, 678, 3, 2, 2, 2, 678, 679, 8, 66,
+	31, 2, 679, 680, 8, 66, 3, 2, 680, 136, 3, 2, 2, 2, 681, 682, 5, 59, 28,
+	2, 682, 683, 3, 2, 2, 2, 683, 684, 8, 67, 32, 2, 684, 685, 8, 67, 3, 2,
+	685, 138, 3, 2, 2, 2, 686, 687, 5, 61, 29, 2, 687, 688, 3, 2, 2, 2, 688,
+	689, 8, 68, 33, 2, 689, 690, 8, 68, 3, 2, 690, 140, 3, 2, 2, 2, 691, 692,
+	5, 63, 30, 2, 692, 693, 3, 2, 2, 2, 693, 694, 8, 69, 34, 2, 694, 695, 8,
+	69, 3, 2, 695, 142, 3, 2, 2, 2, 696, 697, 5, 65, 31, 2, 697, 698, 3, 2,
+	2, 2, 698, 699, 8, 70, 35, 2, 699, 700, 8, 70, 3, 2, 700, 144, 3, 2, 2,
+	2, 701, 702, 5, 67, 32, 2, 702, 703, 3, 2, 2, 2, 703, 704, 8, 71, 36, 2,
+	704, 705, 8, 71, 3, 2, 705, 146, 3, 2, 2, 2, 706, 707, 5, 69, 33, 2, 707,
+	708, 3, 2, 2, 2, 708, 709, 8, 72, 37, 2, 709, 710, 8, 72, 3, 2, 710, 148,
+	3, 2, 2, 2, 711, 712, 5, 71, 34, 2, 712, 713, 3, 2, 2, 2, 713, 714, 8,
+	73, 38, 2, 714, 715, 8, 73, 3, 2, 715, 150, 3, 2, 2, 2, 716, 717, 5, 73,
+	35, 2, 717, 718, 3, 2, 2, 2, 718, 719, 8, 74, 39, 2, 719, 720, 8, 74, 3,
+	2, 720, 152, 3, 2, 2, 2, 721, 722, 5, 75, 36, 2, 722, 723, 3, 2, 2, 2,
+	723, 724, 8, 75, 40, 2, 724, 154, 3, 2, 2, 2, 725, 726, 5, 77, 37, 2, 726,
+	727, 3, 2, 2, 2, 727, 728, 8, 76, 41, 2, 728, 156, 3, 2, 2, 2, 729, 732,
+	5, 161, 79, 2, 730, 732, 5, 159, 78, 2, 731, 729, 3, 2, 2, 2, 731, 730,
+	3, 2, 2, 2, 732, 733, 3, 2, 2, 2, 733, 731, 3, 2, 2, 2, 733, 734, 3, 2,
+	2, 2, 734, 158, 3, 2, 2, 2, 735, 741, 7, 36, 2, 2, 736, 740, 10, 6, 2,
+	2, 737, 738, 7, 94, 2, 2, 738, 740, 7, 36, 2, 2, 739, 736, 3, 2, 2, 2,
+	739, 737, 3, 2, 2, 2, 740, 743, 3, 2, 2, 2, 741, 739, 3, 2, 2, 2, 741,
+	742, 3, 2, 2, 2, 742, 744, 3, 2, 2, 2, 743, 741, 3, 2, 2, 2, 744, 745,
+	7, 36, 2, 2, 745, 160, 3, 2, 2, 2, 746, 749, 10, 7, 2, 2, 747, 749, 5,
+	163, 80, 2, 748, 746, 3, 2, 2, 2, 748, 747, 3, 2, 2, 2, 749, 162, 3, 2,
+	2, 2, 750, 751, 7, 94, 2, 2, 751, 760, 11, 2, 2, 2, 752, 756, 5, 83, 40,
+	2, 753, 755, 9, 4, 2, 2, 754, 753, 3, 2, 2, 2, 755, 758, 3, 2, 2, 2, 756,
+	754, 3, 2, 2, 2, 756, 757, 3, 2, 2, 2, 757, 760, 3, 2, 2, 2, 758, 756,
+	3, 2, 2, 2, 759, 750, 3, 2, 2, 2, 759, 752, 3, 2, 2, 2, 760, 164, 3, 2,
+	2, 2, 761, 762, 5, 75, 36, 2, 762, 763, 3, 2, 2, 2, 763, 764, 8, 81, 40,
+	2, 764, 765, 8, 81, 42, 2, 765, 166, 3, 2, 2, 2, 766, 767, 5, 77, 37, 2,
+	767, 768, 3, 2, 2, 2, 768, 769, 8, 82, 41, 2, 769, 168, 3, 2, 2, 2, 770,
+	771, 7, 63, 2, 2, 771, 772, 3, 2, 2, 2, 772, 773, 8, 83, 43, 2, 773, 170,
+	3, 2, 2, 2, 774, 777, 5, 173, 85, 2, 775, 777, 5, 159, 78, 2, 776, 774,
+	3, 2, 2, 2, 776, 775, 3, 2, 2, 2, 777, 778, 3, 2, 2, 2, 778, 776, 3, 2,
+	2, 2, 778, 779, 3, 2, 2, 2, 779, 780, 3, 2, 2, 2, 780, 781, 8, 84, 44,
+	2, 781, 172, 3, 2, 2, 2, 782, 785, 10, 8, 2, 2, 783, 785, 5, 163, 80, 2,
+	784, 782, 3, 2, 2, 2, 784, 783, 3, 2, 2, 2, 785, 174, 3, 2, 2, 2, 786,
+	787, 5, 75, 36, 2, 787, 788, 3, 2, 2, 2, 788, 789, 8, 86, 40, 2, 789, 790,
+	8, 86, 42, 2, 790, 176, 3, 2, 2, 2, 791, 792, 5, 77, 37, 2, 792, 793, 3,
+	2, 2, 2, 793, 794, 8, 87, 41, 2, 794, 178, 3, 2, 2, 2, 795, 796, 7, 63,
+	2, 2, 796, 797, 3, 2, 2, 2, 797, 798, 8, 88, 45, 2, 798, 180, 3, 2, 2,
+	2, 799, 800, 5, 171, 84, 2, 800, 801, 3, 2, 2, 2, 801, 802, 8, 89, 44,
+	2, 802, 182, 3, 2, 2, 2, 803, 804, 5, 175, 86, 2, 804, 805, 3, 2, 2, 2,
+	805, 806, 8, 90, 40, 2, 806, 807, 8, 90, 42, 2, 807, 184, 3, 2, 2, 2, 808,
+	809, 5, 177, 87, 2, 809, 810, 3, 2, 2, 2, 810, 811, 8, 91, 41, 2, 811,
+	186, 3, 2, 2, 2, 30, 2, 3, 4, 5, 6, 188, 190, 502, 507, 510, 514, 519,
+	521, 527, 533, 540, 546, 548, 731, 733, 739, 741, 748, 756, 759, 776, 778,
+	784, 46, 7, 3, 2, 7, 4, 2, 7, 5, 2, 7, 6, 2, 9, 5, 2, 9, 6, 2, 9, 7, 2,
+	9, 8, 2, 9, 9, 2, 9, 10, 2, 9, 11, 2, 9, 12, 2, 9, 13, 2, 9, 14, 2, 9,
+	15, 2, 9, 16, 2, 9, 17, 2, 9, 18, 2, 9, 19, 2, 9, 20, 2, 9, 21, 2, 9, 22,
+	2, 9, 23, 2, 9, 24, 2, 9, 25, 2, 9, 26, 2, 9, 27, 2, 9, 28, 2, 9, 29, 2,
+	9, 30, 2, 9, 31, 2, 9, 32, 2, 9, 33, 2, 9, 34, 2, 9, 35, 2, 9, 36, 2, 9,
+	37, 2, 9, 38, 2, 9, 39, 2, 9, 40, 2, 6, 2, 2, 4, 4, 2, 9, 41, 2, 9, 42,
+	2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -343,7 +385,8 @@ var lexerLiteralNames = []string{
 	"'SAVE IMAGE'", "'RUN'", "'EXPOSE'", "'VOLUME'", "'ENV'", "'ARG'", "'LABEL'",
 	"'BUILD'", "'WORKDIR'", "'USER'", "'CMD'", "'ENTRYPOINT'", "'GIT CLONE'",
 	"'DOCKER LOAD'", "'DOCKER PULL'", "'ADD'", "'STOPSIGNAL'", "'ONBUILD'",
-	"'HEALTHCHECK'", "'SHELL'", "'WITH DOCKER'", "'END'",
+	"'HEALTHCHECK'", "'SHELL'", "'WITH DOCKER'", "'END'", "'COMMAND'", "'DO'",
+	"'IF'", "'ELSE IF'", "'ELSE'", "'FOR'",
 }
 
 var lexerSymbolicNames = []string{
@@ -351,7 +394,8 @@ var lexerSymbolicNames = []string{
 	"SAVE_IMAGE", "RUN", "EXPOSE", "VOLUME", "ENV", "ARG", "LABEL", "BUILD",
 	"WORKDIR", "USER", "CMD", "ENTRYPOINT", "GIT_CLONE", "DOCKER_LOAD", "DOCKER_PULL",
 	"ADD", "STOPSIGNAL", "ONBUILD", "HEALTHCHECK", "SHELL", "WITH_DOCKER",
-	"END", "Command", "NL", "WS", "Atom", "EQUALS",
+	"END", "COMMAND", "DO", "IF", "ELSE_IF", "ELSE", "FOR", "Command", "NL",
+	"WS", "Atom", "EQUALS",
 }
 
 var lexerRuleNames = []string{
@@ -359,14 +403,16 @@ var lexerRuleNames = []string{
 	"RUN", "EXPOSE", "VOLUME", "ENV", "ARG", "LABEL", "BUILD", "WORKDIR", "USER",
 	"CMD", "ENTRYPOINT", "GIT_CLONE", "DOCKER_LOAD", "DOCKER_PULL", "ADD",
 	"STOPSIGNAL", "ONBUILD", "HEALTHCHECK", "SHELL", "WITH_DOCKER", "END",
-	"Command", "NL", "WS", "CRLF", "COMMENT", "LC", "Target_R", "FROM_R", "FROM_DOCKERFILE_R",
-	"COPY_R", "SAVE_ARTIFACT_R", "SAVE_IMAGE_R", "RUN_R", "EXPOSE_R", "VOLUME_R",
-	"ENV_R", "ARG_R", "LABEL_R", "BUILD_R", "WORKDIR_R", "USER_R", "CMD_R",
-	"ENTRYPOINT_R", "GIT_CLONE_R", "DOCKER_LOAD_R", "DOCKER_PULL_R", "ADD_R",
-	"STOPSIGNAL_R", "ONBUILD_R", "HEALTHCHECK_R", "SHELL_R", "WITH_DOCKER_R",
-	"END_R", "Command_R", "NL_R", "WS_R", "Atom", "QuotedAtomPart", "RegularAtomPart",
-	"EscapedAtomPart", "NL_C", "WS_C", "EQUALS", "Atom_CAKV", "RegularAtomPart_CAKV",
-	"NL_CAKV", "WS_CAKV", "EQUALS_L", "Atom_CAKVL", "NL_CAKVL", "WS_CAKVL",
+	"COMMAND", "DO", "IF", "ELSE_IF", "ELSE", "FOR", "Command", "NL", "WS",
+	"CRLF", "COMMENT", "LC", "Target_R", "FROM_R", "FROM_DOCKERFILE_R", "COPY_R",
+	"SAVE_ARTIFACT_R", "SAVE_IMAGE_R", "RUN_R", "EXPOSE_R", "VOLUME_R", "ENV_R",
+	"ARG_R", "LABEL_R", "BUILD_R", "WORKDIR_R", "USER_R", "CMD_R", "ENTRYPOINT_R",
+	"GIT_CLONE_R", "DOCKER_LOAD_R", "DOCKER_PULL_R", "ADD_R", "STOPSIGNAL_R",
+	"ONBUILD_R", "HEALTHCHECK_R", "SHELL_R", "WITH_DOCKER_R", "END_R", "COMMAND_R",
+	"DO_R", "IF_R", "ELSE_IF_R", "ELSE_R", "FOR_R", "Command_R", "NL_R", "WS_R",
+	"Atom", "QuotedAtomPart", "RegularAtomPart", "EscapedAtomPart", "NL_C",
+	"WS_C", "EQUALS", "Atom_CAKV", "RegularAtomPart_CAKV", "NL_CAKV", "WS_CAKV",
+	"EQUALS_L", "Atom_CAKVL", "NL_CAKVL", "WS_CAKVL",
 }
 
 type EarthLexer struct {
@@ -433,11 +479,17 @@ const (
 	EarthLexerSHELL           = 27
 	EarthLexerWITH_DOCKER     = 28
 	EarthLexerEND             = 29
-	EarthLexerCommand         = 30
-	EarthLexerNL              = 31
-	EarthLexerWS              = 32
-	EarthLexerAtom            = 33
-	EarthLexerEQUALS          = 34
+	EarthLexerCOMMAND         = 30
+	EarthLexerDO              = 31
+	EarthLexerIF              = 32
+	EarthLexerELSE_IF         = 33
+	EarthLexerELSE            = 34
+	EarthLexerFOR             = 35
+	EarthLexerCommand         = 36
+	EarthLexerNL              = 37
+	EarthLexerWS              = 38
+	EarthLexerAtom            = 39
+	EarthLexerEQUALS          = 40
 )
 
 // EarthLexer modes.
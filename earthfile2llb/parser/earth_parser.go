@@ -16,7 +16,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 36, 408,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 42, 550,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -24,186 +24,256 @@ var parserATN = []uint16{
 	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
 	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
 	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4, 39, 9,
-	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 3, 2, 7, 2,
-	88, 10, 2, 12, 2, 14, 2, 91, 11, 2, 3, 2, 3, 2, 3, 2, 5, 2, 96, 10, 2,
-	3, 2, 7, 2, 99, 10, 2, 12, 2, 14, 2, 102, 11, 2, 3, 2, 5, 2, 105, 10, 2,
-	3, 2, 7, 2, 108, 10, 2, 12, 2, 14, 2, 111, 11, 2, 3, 2, 3, 2, 3, 3, 3,
-	3, 5, 3, 117, 10, 3, 3, 3, 6, 3, 120, 10, 3, 13, 3, 14, 3, 121, 3, 3, 3,
-	3, 3, 3, 5, 3, 127, 10, 3, 7, 3, 129, 10, 3, 12, 3, 14, 3, 132, 11, 3,
-	3, 3, 7, 3, 135, 10, 3, 12, 3, 14, 3, 138, 11, 3, 3, 3, 5, 3, 141, 10,
-	3, 3, 4, 3, 4, 6, 4, 145, 10, 4, 13, 4, 14, 4, 146, 3, 4, 5, 4, 150, 10,
-	4, 3, 4, 3, 4, 5, 4, 154, 10, 4, 3, 5, 3, 5, 3, 6, 5, 6, 159, 10, 6, 3,
-	6, 3, 6, 6, 6, 163, 10, 6, 13, 6, 14, 6, 164, 3, 6, 5, 6, 168, 10, 6, 3,
-	6, 7, 6, 171, 10, 6, 12, 6, 14, 6, 174, 11, 6, 3, 7, 3, 7, 3, 7, 3, 7,
+	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44, 9, 44,
+	4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9, 49, 4,
+	50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 3, 2, 7, 2, 106, 10, 2, 12, 2, 14,
+	2, 109, 11, 2, 3, 2, 3, 2, 3, 2, 5, 2, 114, 10, 2, 3, 2, 7, 2, 117, 10,
+	2, 12, 2, 14, 2, 120, 11, 2, 3, 2, 5, 2, 123, 10, 2, 3, 2, 7, 2, 126, 10,
+	2, 12, 2, 14, 2, 129, 11, 2, 3, 2, 3, 2, 3, 3, 3, 3, 5, 3, 135, 10, 3,
+	3, 3, 6, 3, 138, 10, 3, 13, 3, 14, 3, 139, 3, 3, 3, 3, 3, 3, 5, 3, 145,
+	10, 3, 7, 3, 147, 10, 3, 12, 3, 14, 3, 150, 11, 3, 3, 3, 7, 3, 153, 10,
+	3, 12, 3, 14, 3, 156, 11, 3, 3, 3, 5, 3, 159, 10, 3, 3, 4, 3, 4, 6, 4,
+	163, 10, 4, 13, 4, 14, 4, 164, 3, 4, 5, 4, 168, 10, 4, 3, 4, 3, 4, 5, 4,
+	172, 10, 4, 3, 5, 3, 5, 3, 6, 5, 6, 177, 10, 6, 3, 6, 3, 6, 6, 6, 181,
+	10, 6, 13, 6, 14, 6, 182, 3, 6, 5, 6, 186, 10, 6, 3, 6, 7, 6, 189, 10,
+	6, 12, 6, 14, 6, 192, 11, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
 	3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7,
-	3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 202,
-	10, 7, 3, 8, 3, 8, 3, 8, 5, 8, 207, 10, 8, 3, 9, 3, 9, 3, 9, 5, 9, 212,
-	10, 9, 3, 10, 3, 10, 3, 10, 5, 10, 217, 10, 10, 3, 11, 3, 11, 5, 11, 221,
-	10, 11, 3, 12, 3, 12, 3, 12, 5, 12, 226, 10, 12, 3, 13, 3, 13, 3, 13, 5,
-	13, 231, 10, 13, 3, 14, 3, 14, 3, 14, 5, 14, 236, 10, 14, 3, 15, 3, 15,
-	3, 15, 5, 15, 241, 10, 15, 3, 16, 3, 16, 3, 16, 5, 16, 246, 10, 16, 3,
-	17, 3, 17, 3, 17, 5, 17, 251, 10, 17, 3, 18, 3, 18, 3, 18, 5, 18, 256,
-	10, 18, 3, 19, 3, 19, 3, 19, 5, 19, 261, 10, 19, 3, 20, 3, 20, 3, 20, 5,
-	20, 266, 10, 20, 3, 21, 3, 21, 3, 21, 5, 21, 271, 10, 21, 3, 22, 3, 22,
-	3, 22, 3, 22, 5, 22, 277, 10, 22, 3, 22, 5, 22, 280, 10, 22, 3, 22, 5,
-	22, 283, 10, 22, 3, 22, 5, 22, 286, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23,
-	5, 23, 292, 10, 23, 3, 23, 3, 23, 3, 23, 5, 23, 297, 10, 23, 3, 23, 5,
-	23, 300, 10, 23, 5, 23, 302, 10, 23, 3, 24, 3, 24, 3, 25, 3, 25, 5, 25,
-	308, 10, 25, 3, 25, 7, 25, 311, 10, 25, 12, 25, 14, 25, 314, 11, 25, 3,
-	26, 3, 26, 3, 26, 3, 26, 5, 26, 320, 10, 26, 3, 26, 3, 26, 5, 26, 324,
-	10, 26, 3, 26, 3, 26, 7, 26, 328, 10, 26, 12, 26, 14, 26, 331, 11, 26,
-	3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 5, 29, 340, 10, 29, 3,
-	30, 3, 30, 3, 30, 5, 30, 345, 10, 30, 3, 31, 3, 31, 3, 31, 5, 31, 350,
-	10, 31, 3, 32, 3, 32, 3, 32, 5, 32, 355, 10, 32, 3, 33, 3, 33, 3, 33, 5,
-	33, 360, 10, 33, 3, 34, 3, 34, 3, 34, 5, 34, 365, 10, 34, 3, 35, 3, 35,
-	3, 35, 5, 35, 370, 10, 35, 3, 36, 3, 36, 3, 36, 5, 36, 375, 10, 36, 3,
-	37, 3, 37, 3, 37, 5, 37, 380, 10, 37, 3, 38, 3, 38, 3, 38, 5, 38, 385,
-	10, 38, 3, 39, 3, 39, 3, 39, 5, 39, 390, 10, 39, 3, 40, 3, 40, 3, 41, 3,
-	41, 3, 42, 3, 42, 5, 42, 398, 10, 42, 3, 42, 7, 42, 401, 10, 42, 12, 42,
-	14, 42, 404, 11, 42, 3, 43, 3, 43, 3, 43, 2, 2, 44, 2, 4, 6, 8, 10, 12,
+	3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 223,
+	10, 7, 3, 8, 3, 8, 3, 8, 5, 8, 228, 10, 8, 3, 9, 3, 9, 3, 9, 5, 9, 233,
+	10, 9, 3, 10, 3, 10, 3, 10, 5, 10, 238, 10, 10, 3, 11, 3, 11, 5, 11, 242,
+	10, 11, 3, 12, 3, 12, 3, 12, 5, 12, 247, 10, 12, 3, 13, 3, 13, 3, 13, 5,
+	13, 252, 10, 13, 3, 14, 3, 14, 3, 14, 5, 14, 257, 10, 14, 3, 15, 3, 15,
+	3, 15, 5, 15, 262, 10, 15, 3, 16, 3, 16, 3, 16, 5, 16, 267, 10, 16, 3,
+	17, 3, 17, 3, 17, 5, 17, 272, 10, 17, 3, 18, 3, 18, 3, 18, 5, 18, 277,
+	10, 18, 3, 19, 3, 19, 3, 19, 5, 19, 282, 10, 19, 3, 20, 3, 20, 3, 20, 5,
+	20, 287, 10, 20, 3, 21, 3, 21, 3, 21, 5, 21, 292, 10, 21, 3, 22, 3, 22,
+	3, 22, 3, 22, 5, 22, 298, 10, 22, 3, 22, 5, 22, 301, 10, 22, 3, 22, 5,
+	22, 304, 10, 22, 3, 22, 5, 22, 307, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23,
+	5, 23, 313, 10, 23, 3, 23, 3, 23, 3, 23, 5, 23, 318, 10, 23, 3, 23, 5,
+	23, 321, 10, 23, 5, 23, 323, 10, 23, 3, 24, 3, 24, 3, 25, 3, 25, 5, 25,
+	329, 10, 25, 3, 25, 7, 25, 332, 10, 25, 12, 25, 14, 25, 335, 11, 25, 3,
+	26, 3, 26, 3, 26, 3, 26, 5, 26, 341, 10, 26, 3, 26, 3, 26, 5, 26, 345,
+	10, 26, 3, 26, 3, 26, 7, 26, 349, 10, 26, 12, 26, 14, 26, 352, 11, 26,
+	3, 27, 3, 27, 3, 28, 3, 28, 3, 29, 3, 29, 3, 29, 5, 29, 361, 10, 29, 3,
+	30, 3, 30, 3, 30, 5, 30, 366, 10, 30, 3, 31, 3, 31, 3, 31, 5, 31, 371,
+	10, 31, 3, 32, 3, 32, 3, 32, 5, 32, 376, 10, 32, 3, 33, 3, 33, 3, 33, 5,
+	33, 381, 10, 33, 3, 34, 3, 34, 3, 34, 5, 34, 386, 10, 34, 3, 35, 3, 35,
+	3, 35, 5, 35, 391, 10, 35, 3, 36, 3, 36, 3, 36, 5, 36, 396, 10, 36, 3,
+	37, 3, 37, 6, 37, 400, 10, 37, 13, 37, 14, 37, 401, 3, 37, 5, 37, 405,
+	10, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 5, 38, 412, 10, 38, 3, 38, 6,
+	38, 415, 10, 38, 13, 38, 14, 38, 416, 3, 38, 5, 38, 420, 10, 38, 3, 39,
+	3, 39, 6, 39, 424, 10, 39, 13, 39, 14, 39, 425, 3, 39, 5, 39, 429, 10,
+	39, 3, 39, 7, 39, 432, 10, 39, 12, 39, 14, 39, 435, 11, 39, 3, 39, 6, 39,
+	438, 10, 39, 13, 39, 14, 39, 439, 3, 39, 5, 39, 443, 10, 39, 3, 39, 5,
+	39, 446, 10, 39, 3, 39, 6, 39, 449, 10, 39, 13, 39, 14, 39, 450, 3, 39,
+	5, 39, 454, 10, 39, 3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 5, 40, 461, 10,
+	40, 3, 40, 6, 40, 464, 10, 40, 13, 40, 14, 40, 465, 3, 40, 5, 40, 469,
+	10, 40, 3, 41, 3, 41, 3, 41, 5, 41, 474, 10, 41, 3, 41, 6, 41, 477, 10,
+	41, 13, 41, 14, 41, 478, 3, 41, 5, 41, 482, 10, 41, 3, 42, 3, 42, 6, 42,
+	486, 10, 42, 13, 42, 14, 42, 487, 3, 42, 5, 42, 491, 10, 42, 3, 43, 3,
+	43, 6, 43, 495, 10, 43, 13, 43, 14, 43, 496, 3, 43, 5, 43, 500, 10, 43,
+	3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 5, 44, 507, 10, 44, 3, 44, 6, 44, 510,
+	10, 44, 13, 44, 14, 44, 511, 3, 44, 5, 44, 515, 10, 44, 3, 45, 3, 45, 3,
+	46, 3, 46, 3, 46, 5, 46, 522, 10, 46, 3, 47, 3, 47, 3, 47, 5, 47, 527,
+	10, 47, 3, 48, 3, 48, 3, 48, 5, 48, 532, 10, 48, 3, 49, 3, 49, 3, 50, 3,
+	50, 3, 51, 3, 51, 5, 51, 540, 10, 51, 3, 51, 7, 51, 543, 10, 51, 12, 51,
+	14, 51, 546, 11, 51, 3, 52, 3, 52, 3, 52, 2, 2, 53, 2, 4, 6, 8, 10, 12,
 	14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48,
 	50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84,
-	2, 2, 2, 448, 2, 89, 3, 2, 2, 2, 4, 114, 3, 2, 2, 2, 6, 142, 3, 2, 2, 2,
-	8, 155, 3, 2, 2, 2, 10, 158, 3, 2, 2, 2, 12, 201, 3, 2, 2, 2, 14, 203,
-	3, 2, 2, 2, 16, 208, 3, 2, 2, 2, 18, 213, 3, 2, 2, 2, 20, 220, 3, 2, 2,
-	2, 22, 222, 3, 2, 2, 2, 24, 227, 3, 2, 2, 2, 26, 232, 3, 2, 2, 2, 28, 237,
-	3, 2, 2, 2, 30, 242, 3, 2, 2, 2, 32, 247, 3, 2, 2, 2, 34, 252, 3, 2, 2,
-	2, 36, 257, 3, 2, 2, 2, 38, 262, 3, 2, 2, 2, 40, 267, 3, 2, 2, 2, 42, 272,
-	3, 2, 2, 2, 44, 287, 3, 2, 2, 2, 46, 303, 3, 2, 2, 2, 48, 305, 3, 2, 2,
-	2, 50, 315, 3, 2, 2, 2, 52, 332, 3, 2, 2, 2, 54, 334, 3, 2, 2, 2, 56, 336,
-	3, 2, 2, 2, 58, 341, 3, 2, 2, 2, 60, 346, 3, 2, 2, 2, 62, 351, 3, 2, 2,
-	2, 64, 356, 3, 2, 2, 2, 66, 361, 3, 2, 2, 2, 68, 366, 3, 2, 2, 2, 70, 371,
-	3, 2, 2, 2, 72, 376, 3, 2, 2, 2, 74, 381, 3, 2, 2, 2, 76, 386, 3, 2, 2,
-	2, 78, 391, 3, 2, 2, 2, 80, 393, 3, 2, 2, 2, 82, 395, 3, 2, 2, 2, 84, 405,
-	3, 2, 2, 2, 86, 88, 7, 33, 2, 2, 87, 86, 3, 2, 2, 2, 88, 91, 3, 2, 2, 2,
-	89, 87, 3, 2, 2, 2, 89, 90, 3, 2, 2, 2, 90, 95, 3, 2, 2, 2, 91, 89, 3,
-	2, 2, 2, 92, 93, 5, 10, 6, 2, 93, 94, 7, 33, 2, 2, 94, 96, 3, 2, 2, 2,
-	95, 92, 3, 2, 2, 2, 95, 96, 3, 2, 2, 2, 96, 100, 3, 2, 2, 2, 97, 99, 7,
-	33, 2, 2, 98, 97, 3, 2, 2, 2, 99, 102, 3, 2, 2, 2, 100, 98, 3, 2, 2, 2,
-	100, 101, 3, 2, 2, 2, 101, 104, 3, 2, 2, 2, 102, 100, 3, 2, 2, 2, 103,
-	105, 5, 4, 3, 2, 104, 103, 3, 2, 2, 2, 104, 105, 3, 2, 2, 2, 105, 109,
-	3, 2, 2, 2, 106, 108, 7, 33, 2, 2, 107, 106, 3, 2, 2, 2, 108, 111, 3, 2,
-	2, 2, 109, 107, 3, 2, 2, 2, 109, 110, 3, 2, 2, 2, 110, 112, 3, 2, 2, 2,
-	111, 109, 3, 2, 2, 2, 112, 113, 7, 2, 2, 3, 113, 3, 3, 2, 2, 2, 114, 116,
-	5, 6, 4, 2, 115, 117, 7, 34, 2, 2, 116, 115, 3, 2, 2, 2, 116, 117, 3, 2,
-	2, 2, 117, 130, 3, 2, 2, 2, 118, 120, 7, 33, 2, 2, 119, 118, 3, 2, 2, 2,
-	120, 121, 3, 2, 2, 2, 121, 119, 3, 2, 2, 2, 121, 122, 3, 2, 2, 2, 122,
-	123, 3, 2, 2, 2, 123, 124, 7, 4, 2, 2, 124, 126, 5, 6, 4, 2, 125, 127,
-	7, 34, 2, 2, 126, 125, 3, 2, 2, 2, 126, 127, 3, 2, 2, 2, 127, 129, 3, 2,
-	2, 2, 128, 119, 3, 2, 2, 2, 129, 132, 3, 2, 2, 2, 130, 128, 3, 2, 2, 2,
-	130, 131, 3, 2, 2, 2, 131, 136, 3, 2, 2, 2, 132, 130, 3, 2, 2, 2, 133,
-	135, 7, 33, 2, 2, 134, 133, 3, 2, 2, 2, 135, 138, 3, 2, 2, 2, 136, 134,
-	3, 2, 2, 2, 136, 137, 3, 2, 2, 2, 137, 140, 3, 2, 2, 2, 138, 136, 3, 2,
-	2, 2, 139, 141, 7, 4, 2, 2, 140, 139, 3, 2, 2, 2, 140, 141, 3, 2, 2, 2,
-	141, 5, 3, 2, 2, 2, 142, 144, 5, 8, 5, 2, 143, 145, 7, 33, 2, 2, 144, 143,
-	3, 2, 2, 2, 145, 146, 3, 2, 2, 2, 146, 144, 3, 2, 2, 2, 146, 147, 3, 2,
-	2, 2, 147, 149, 3, 2, 2, 2, 148, 150, 7, 34, 2, 2, 149, 148, 3, 2, 2, 2,
-	149, 150, 3, 2, 2, 2, 150, 151, 3, 2, 2, 2, 151, 153, 7, 3, 2, 2, 152,
-	154, 5, 10, 6, 2, 153, 152, 3, 2, 2, 2, 153, 154, 3, 2, 2, 2, 154, 7, 3,
-	2, 2, 2, 155, 156, 7, 5, 2, 2, 156, 9, 3, 2, 2, 2, 157, 159, 7, 34, 2,
-	2, 158, 157, 3, 2, 2, 2, 158, 159, 3, 2, 2, 2, 159, 160, 3, 2, 2, 2, 160,
-	172, 5, 12, 7, 2, 161, 163, 7, 33, 2, 2, 162, 161, 3, 2, 2, 2, 163, 164,
-	3, 2, 2, 2, 164, 162, 3, 2, 2, 2, 164, 165, 3, 2, 2, 2, 165, 167, 3, 2,
-	2, 2, 166, 168, 7, 34, 2, 2, 167, 166, 3, 2, 2, 2, 167, 168, 3, 2, 2, 2,
-	168, 169, 3, 2, 2, 2, 169, 171, 5, 12, 7, 2, 170, 162, 3, 2, 2, 2, 171,
-	174, 3, 2, 2, 2, 172, 170, 3, 2, 2, 2, 172, 173, 3, 2, 2, 2, 173, 11, 3,
-	2, 2, 2, 174, 172, 3, 2, 2, 2, 175, 202, 5, 14, 8, 2, 176, 202, 5, 16,
-	9, 2, 177, 202, 5, 18, 10, 2, 178, 202, 5, 20, 11, 2, 179, 202, 5, 26,
-	14, 2, 180, 202, 5, 28, 15, 2, 181, 202, 5, 30, 16, 2, 182, 202, 5, 32,
-	17, 2, 183, 202, 5, 34, 18, 2, 184, 202, 5, 36, 19, 2, 185, 202, 5, 38,
-	20, 2, 186, 202, 5, 40, 21, 2, 187, 202, 5, 42, 22, 2, 188, 202, 5, 44,
-	23, 2, 189, 202, 5, 50, 26, 2, 190, 202, 5, 56, 29, 2, 191, 202, 5, 58,
-	30, 2, 192, 202, 5, 60, 31, 2, 193, 202, 5, 62, 32, 2, 194, 202, 5, 64,
-	33, 2, 195, 202, 5, 66, 34, 2, 196, 202, 5, 68, 35, 2, 197, 202, 5, 70,
-	36, 2, 198, 202, 5, 72, 37, 2, 199, 202, 5, 74, 38, 2, 200, 202, 5, 76,
-	39, 2, 201, 175, 3, 2, 2, 2, 201, 176, 3, 2, 2, 2, 201, 177, 3, 2, 2, 2,
-	201, 178, 3, 2, 2, 2, 201, 179, 3, 2, 2, 2, 201, 180, 3, 2, 2, 2, 201,
-	181, 3, 2, 2, 2, 201, 182, 3, 2, 2, 2, 201, 183, 3, 2, 2, 2, 201, 184,
-	3, 2, 2, 2, 201, 185, 3, 2, 2, 2, 201, 186, 3, 2, 2, 2, 201, 187, 3, 2,
-	2, 2, 201, 188, 3, 2, 2, 2, 201, 189, 3, 2, 2, 2, 201, 190, 3, 2, 2, 2,
-	201, 191, 3, 2, 2, 2, 201, 192, 3, 2, 2, 2, 201, 193, 3, 2, 2, 2, 201,
-	194, 3, 2, 2, 2, 201, 195, 3, 2, 2, 2, 201, 196, 3, 2, 2, 2, 201, 197,
-	3, 2, 2, 2, 201, 198, 3, 2, 2, 2, 201, 199, 3, 2, 2, 2, 201, 200, 3, 2,
-	2, 2, 202, 13, 3, 2, 2, 2, 203, 206, 7, 6, 2, 2, 204, 205, 7, 34, 2, 2,
-	205, 207, 5, 82, 42, 2, 206, 204, 3, 2, 2, 2, 206, 207, 3, 2, 2, 2, 207,
-	15, 3, 2, 2, 2, 208, 211, 7, 7, 2, 2, 209, 210, 7, 34, 2, 2, 210, 212,
-	5, 82, 42, 2, 211, 209, 3, 2, 2, 2, 211, 212, 3, 2, 2, 2, 212, 17, 3, 2,
-	2, 2, 213, 216, 7, 8, 2, 2, 214, 215, 7, 34, 2, 2, 215, 217, 5, 82, 42,
-	2, 216, 214, 3, 2, 2, 2, 216, 217, 3, 2, 2, 2, 217, 19, 3, 2, 2, 2, 218,
-	221, 5, 24, 13, 2, 219, 221, 5, 22, 12, 2, 220, 218, 3, 2, 2, 2, 220, 219,
-	3, 2, 2, 2, 221, 21, 3, 2, 2, 2, 222, 225, 7, 10, 2, 2, 223, 224, 7, 34,
-	2, 2, 224, 226, 5, 82, 42, 2, 225, 223, 3, 2, 2, 2, 225, 226, 3, 2, 2,
-	2, 226, 23, 3, 2, 2, 2, 227, 230, 7, 9, 2, 2, 228, 229, 7, 34, 2, 2, 229,
-	231, 5, 82, 42, 2, 230, 228, 3, 2, 2, 2, 230, 231, 3, 2, 2, 2, 231, 25,
-	3, 2, 2, 2, 232, 235, 7, 11, 2, 2, 233, 234, 7, 34, 2, 2, 234, 236, 5,
-	80, 41, 2, 235, 233, 3, 2, 2, 2, 235, 236, 3, 2, 2, 2, 236, 27, 3, 2, 2,
-	2, 237, 240, 7, 17, 2, 2, 238, 239, 7, 34, 2, 2, 239, 241, 5, 82, 42, 2,
-	240, 238, 3, 2, 2, 2, 240, 241, 3, 2, 2, 2, 241, 29, 3, 2, 2, 2, 242, 245,
-	7, 18, 2, 2, 243, 244, 7, 34, 2, 2, 244, 246, 5, 82, 42, 2, 245, 243, 3,
-	2, 2, 2, 245, 246, 3, 2, 2, 2, 246, 31, 3, 2, 2, 2, 247, 250, 7, 19, 2,
-	2, 248, 249, 7, 34, 2, 2, 249, 251, 5, 82, 42, 2, 250, 248, 3, 2, 2, 2,
-	250, 251, 3, 2, 2, 2, 251, 33, 3, 2, 2, 2, 252, 255, 7, 20, 2, 2, 253,
-	254, 7, 34, 2, 2, 254, 256, 5, 80, 41, 2, 255, 253, 3, 2, 2, 2, 255, 256,
-	3, 2, 2, 2, 256, 35, 3, 2, 2, 2, 257, 260, 7, 21, 2, 2, 258, 259, 7, 34,
-	2, 2, 259, 261, 5, 80, 41, 2, 260, 258, 3, 2, 2, 2, 260, 261, 3, 2, 2,
-	2, 261, 37, 3, 2, 2, 2, 262, 265, 7, 12, 2, 2, 263, 264, 7, 34, 2, 2, 264,
-	266, 5, 82, 42, 2, 265, 263, 3, 2, 2, 2, 265, 266, 3, 2, 2, 2, 266, 39,
-	3, 2, 2, 2, 267, 270, 7, 13, 2, 2, 268, 269, 7, 34, 2, 2, 269, 271, 5,
-	80, 41, 2, 270, 268, 3, 2, 2, 2, 270, 271, 3, 2, 2, 2, 271, 41, 3, 2, 2,
-	2, 272, 273, 7, 14, 2, 2, 273, 274, 7, 34, 2, 2, 274, 279, 5, 46, 24, 2,
-	275, 277, 7, 34, 2, 2, 276, 275, 3, 2, 2, 2, 276, 277, 3, 2, 2, 2, 277,
-	278, 3, 2, 2, 2, 278, 280, 7, 36, 2, 2, 279, 276, 3, 2, 2, 2, 279, 280,
-	3, 2, 2, 2, 280, 285, 3, 2, 2, 2, 281, 283, 7, 34, 2, 2, 282, 281, 3, 2,
-	2, 2, 282, 283, 3, 2, 2, 2, 283, 284, 3, 2, 2, 2, 284, 286, 5, 48, 25,
-	2, 285, 282, 3, 2, 2, 2, 285, 286, 3, 2, 2, 2, 286, 43, 3, 2, 2, 2, 287,
-	288, 7, 15, 2, 2, 288, 289, 7, 34, 2, 2, 289, 301, 5, 46, 24, 2, 290, 292,
-	7, 34, 2, 2, 291, 290, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2, 292, 293, 3, 2,
-	2, 2, 293, 294, 7, 36, 2, 2, 294, 299, 3, 2, 2, 2, 295, 297, 7, 34, 2,
-	2, 296, 295, 3, 2, 2, 2, 296, 297, 3, 2, 2, 2, 297, 298, 3, 2, 2, 2, 298,
-	300, 5, 48, 25, 2, 299, 296, 3, 2, 2, 2, 299, 300, 3, 2, 2, 2, 300, 302,
-	3, 2, 2, 2, 301, 291, 3, 2, 2, 2, 301, 302, 3, 2, 2, 2, 302, 45, 3, 2,
-	2, 2, 303, 304, 7, 35, 2, 2, 304, 47, 3, 2, 2, 2, 305, 312, 7, 35, 2, 2,
-	306, 308, 7, 34, 2, 2, 307, 306, 3, 2, 2, 2, 307, 308, 3, 2, 2, 2, 308,
-	309, 3, 2, 2, 2, 309, 311, 7, 35, 2, 2, 310, 307, 3, 2, 2, 2, 311, 314,
-	3, 2, 2, 2, 312, 310, 3, 2, 2, 2, 312, 313, 3, 2, 2, 2, 313, 49, 3, 2,
-	2, 2, 314, 312, 3, 2, 2, 2, 315, 329, 7, 16, 2, 2, 316, 317, 7, 34, 2,
-	2, 317, 319, 5, 52, 27, 2, 318, 320, 7, 34, 2, 2, 319, 318, 3, 2, 2, 2,
-	319, 320, 3, 2, 2, 2, 320, 321, 3, 2, 2, 2, 321, 323, 7, 36, 2, 2, 322,
-	324, 7, 34, 2, 2, 323, 322, 3, 2, 2, 2, 323, 324, 3, 2, 2, 2, 324, 325,
-	3, 2, 2, 2, 325, 326, 5, 54, 28, 2, 326, 328, 3, 2, 2, 2, 327, 316, 3,
-	2, 2, 2, 328, 331, 3, 2, 2, 2, 329, 327, 3, 2, 2, 2, 329, 330, 3, 2, 2,
-	2, 330, 51, 3, 2, 2, 2, 331, 329, 3, 2, 2, 2, 332, 333, 7, 35, 2, 2, 333,
-	53, 3, 2, 2, 2, 334, 335, 7, 35, 2, 2, 335, 55, 3, 2, 2, 2, 336, 339, 7,
-	22, 2, 2, 337, 338, 7, 34, 2, 2, 338, 340, 5, 82, 42, 2, 339, 337, 3, 2,
-	2, 2, 339, 340, 3, 2, 2, 2, 340, 57, 3, 2, 2, 2, 341, 344, 7, 23, 2, 2,
-	342, 343, 7, 34, 2, 2, 343, 345, 5, 82, 42, 2, 344, 342, 3, 2, 2, 2, 344,
-	345, 3, 2, 2, 2, 345, 59, 3, 2, 2, 2, 346, 349, 7, 24, 2, 2, 347, 348,
-	7, 34, 2, 2, 348, 350, 5, 82, 42, 2, 349, 347, 3, 2, 2, 2, 349, 350, 3,
-	2, 2, 2, 350, 61, 3, 2, 2, 2, 351, 354, 7, 25, 2, 2, 352, 353, 7, 34, 2,
-	2, 353, 355, 5, 82, 42, 2, 354, 352, 3, 2, 2, 2, 354, 355, 3, 2, 2, 2,
-	355, 63, 3, 2, 2, 2, 356, 359, 7, 26, 2, 2, 357, 358, 7, 34, 2, 2, 358,
-	360, 5, 82, 42, 2, 359, 357, 3, 2, 2, 2, 359, 360, 3, 2, 2, 2, 360, 65,
-	3, 2, 2, 2, 361, 364, 7, 27, 2, 2, 362, 363, 7, 34, 2, 2, 363, 365, 5,
-	82, 42, 2, 364, 362, 3, 2, 2, 2, 364, 365, 3, 2, 2, 2, 365, 67, 3, 2, 2,
-	2, 366, 369, 7, 28, 2, 2, 367, 368, 7, 34, 2, 2, 368, 370, 5, 82, 42, 2,
-	369, 367, 3, 2, 2, 2, 369, 370, 3, 2, 2, 2, 370, 69, 3, 2, 2, 2, 371, 374,
-	7, 29, 2, 2, 372, 373, 7, 34, 2, 2, 373, 375, 5, 82, 42, 2, 374, 372, 3,
-	2, 2, 2, 374, 375, 3, 2, 2, 2, 375, 71, 3, 2, 2, 2, 376, 379, 7, 30, 2,
-	2, 377, 378, 7, 34, 2, 2, 378, 380, 5, 82, 42, 2, 379, 377, 3, 2, 2, 2,
-	379, 380, 3, 2, 2, 2, 380, 73, 3, 2, 2, 2, 381, 384, 7, 31, 2, 2, 382,
-	383, 7, 34, 2, 2, 383, 385, 5, 82, 42, 2, 384, 382, 3, 2, 2, 2, 384, 385,
-	3, 2, 2, 2, 385, 75, 3, 2, 2, 2, 386, 389, 5, 78, 40, 2, 387, 388, 7, 34,
-	2, 2, 388, 390, 5, 82, 42, 2, 389, 387, 3, 2, 2, 2, 389, 390, 3, 2, 2,
-	2, 390, 77, 3, 2, 2, 2, 391, 392, 7, 32, 2, 2, 392, 79, 3, 2, 2, 2, 393,
-	394, 5, 82, 42, 2, 394, 81, 3, 2, 2, 2, 395, 402, 5, 84, 43, 2, 396, 398,
-	7, 34, 2, 2, 397, 396, 3, 2, 2, 2, 397, 398, 3, 2, 2, 2, 398, 399, 3, 2,
-	2, 2, 399, 401, 5, 84, 43, 2, 400, 397, 3, 2, 2, 2, 401, 404, 3, 2, 2,
-	2, 402, 400, 3, 2, 2, 2, 402, 403, 3, 2, 2, 2, 403, 83, 3, 2, 2, 2, 404,
-	402, 3, 2, 2, 2, 405, 406, 7, 35, 2, 2, 406, 85, 3, 2, 2, 2, 61, 89, 95,
-	100, 104, 109, 116, 121, 126, 130, 136, 140, 146, 149, 153, 158, 164, 167,
-	172, 201, 206, 211, 216, 220, 225, 230, 235, 240, 245, 250, 255, 260, 265,
-	270, 276, 279, 282, 285, 291, 296, 299, 301, 307, 312, 319, 323, 329, 339,
-	344, 349, 354, 359, 364, 369, 374, 379, 384, 389, 397, 402,
+	86, 88, 90, 92, 94, 96, 98, 100, 102, 2, 2, 2, 610, 2, 107, 3, 2, 2, 2,
+	4, 132, 3, 2, 2, 2, 6, 160, 3, 2, 2, 2, 8, 173, 3, 2, 2, 2, 10, 176, 3,
+	2, 2, 2, 12, 222, 3, 2, 2, 2, 14, 224, 3, 2, 2, 2, 16, 229, 3, 2, 2, 2,
+	18, 234, 3, 2, 2, 2, 20, 241, 3, 2, 2, 2, 22, 243, 3, 2, 2, 2, 24, 248,
+	3, 2, 2, 2, 26, 253, 3, 2, 2, 2, 28, 258, 3, 2, 2, 2, 30, 263, 3, 2, 2,
+	2, 32, 268, 3, 2, 2, 2, 34, 273, 3, 2, 2, 2, 36, 278, 3, 2, 2, 2, 38, 283,
+	3, 2, 2, 2, 40, 288, 3, 2, 2, 2, 42, 293, 3, 2, 2, 2, 44, 308, 3, 2, 2,
+	2, 46, 324, 3, 2, 2, 2, 48, 326, 3, 2, 2, 2, 50, 336, 3, 2, 2, 2, 52, 353,
+	3, 2, 2, 2, 54, 355, 3, 2, 2, 2, 56, 357, 3, 2, 2, 2, 58, 362, 3, 2, 2,
+	2, 60, 367, 3, 2, 2, 2, 62, 372, 3, 2, 2, 2, 64, 377, 3, 2, 2, 2, 66, 382,
+	3, 2, 2, 2, 68, 387, 3, 2, 2, 2, 70, 392, 3, 2, 2, 2, 72, 397, 3, 2, 2,
+	2, 74, 408, 3, 2, 2, 2, 76, 421, 3, 2, 2, 2, 78, 457, 3, 2, 2, 2, 80, 470,
+	3, 2, 2, 2, 82, 483, 3, 2, 2, 2, 84, 492, 3, 2, 2, 2, 86, 503, 3, 2, 2,
+	2, 88, 516, 3, 2, 2, 2, 90, 518, 3, 2, 2, 2, 92, 523, 3, 2, 2, 2, 94, 528,
+	3, 2, 2, 2, 96, 533, 3, 2, 2, 2, 98, 535, 3, 2, 2, 2, 100, 537, 3, 2, 2,
+	2, 102, 547, 3, 2, 2, 2, 104, 106, 7, 39, 2, 2, 105, 104, 3, 2, 2, 2, 106,
+	109, 3, 2, 2, 2, 107, 105, 3, 2, 2, 2, 107, 108, 3, 2, 2, 2, 108, 113,
+	3, 2, 2, 2, 109, 107, 3, 2, 2, 2, 110, 111, 5, 10, 6, 2, 111, 112, 7, 39,
+	2, 2, 112, 114, 3, 2, 2, 2, 113, 110, 3, 2, 2, 2, 113, 114, 3, 2, 2, 2,
+	114, 118, 3, 2, 2, 2, 115, 117, 7, 39, 2, 2, 116, 115, 3, 2, 2, 2, 117,
+	120, 3, 2, 2, 2, 118, 116, 3, 2, 2, 2, 118, 119, 3, 2, 2, 2, 119, 122,
+	3, 2, 2, 2, 120, 118, 3, 2, 2, 2, 121, 123, 5, 4, 3, 2, 122, 121, 3, 2,
+	2, 2, 122, 123, 3, 2, 2, 2, 123, 127, 3, 2, 2, 2, 124, 126, 7, 39, 2, 2,
+	125, 124, 3, 2, 2, 2, 126, 129, 3, 2, 2, 2, 127, 125, 3, 2, 2, 2, 127,
+	128, 3, 2, 2, 2, 128, 130, 3, 2, 2, 2, 129, 127, 3, 2, 2, 2, 130, 131,
+	7, 2, 2, 3, 131, 3, 3, 2, 2, 2, 132, 134, 5, 6, 4, 2, 133, 135, 7, 40,
+	2, 2, 134, 133, 3, 2, 2, 2, 134, 135, 3, 2, 2, 2, 135, 148, 3, 2, 2, 2,
+	136, 138, 7, 39, 2, 2, 137, 136, 3, 2, 2, 2, 138, 139, 3, 2, 2, 2, 139,
+	137, 3, 2, 2, 2, 139, 140, 3, 2, 2, 2, 140, 141, 3, 2, 2, 2, 141, 142,
+	7, 4, 2, 2, 142, 144, 5, 6, 4, 2, 143, 145, 7, 40, 2, 2, 144, 143, 3, 2,
+	2, 2, 144, 145, 3, 2, 2, 2, 145, 147, 3, 2, 2, 2, 146, 137, 3, 2, 2, 2,
+	147, 150, 3, 2, 2, 2, 148, 146, 3, 2, 2, 2, 148, 149, 3, 2, 2, 2, 149,
+	154, 3, 2, 2, 2, 150, 148, 3, 2, 2, 2, 151, 153, 7, 39, 2, 2, 152, 151,
+	3, 2, 2, 2, 153, 156, 3, 2, 2, 2, 154, 152, 3, 2, 2, 2, 154, 155, 3, 2,
+	2, 2, 155, 158, 3, 2, 2, 2, 156, 154, 3, 2, 2, 2, 157, 159, 7, 4, 2, 2,
+	158, 157, 3, 2, 2, 2, 158, 159, 3, 2, 2, 2, 159, 5, 3, 2, 2, 2, 160, 162,
+	5, 8, 5, 2, 161, 163, 7, 39, 2, 2, 162, 161, 3, 2, 2, 2, 163, 164, 3, 2,
+	2, 2, 164, 162, 3, 2, 2, 2, 164, 165, 3, 2, 2, 2, 165, 167, 3, 2, 2, 2,
+	166, 168, 7, 40, 2, 2, 167, 166, 3, 2, 2, 2, 167, 168, 3, 2, 2, 2, 168,
+	169, 3, 2, 2, 2, 169, 171, 7, 3, 2, 2, 170, 172, 5, 10, 6, 2, 171, 170,
+	3, 2, 2, 2, 171, 172, 3, 2, 2, 2, 172, 7, 3, 2, 2, 2, 173, 174, 7, 5, 2,
+	2, 174, 9, 3, 2, 2, 2, 175, 177, 7, 40, 2, 2, 176, 175, 3, 2, 2, 2, 176,
+	177, 3, 2, 2, 2, 177, 178, 3, 2, 2, 2, 178, 190, 5, 12, 7, 2, 179, 181,
+	7, 39, 2, 2, 180, 179, 3, 2, 2, 2, 181, 182, 3, 2, 2, 2, 182, 180, 3, 2,
+	2, 2, 182, 183, 3, 2, 2, 2, 183, 185, 3, 2, 2, 2, 184, 186, 7, 40, 2, 2,
+	185, 184, 3, 2, 2, 2, 185, 186, 3, 2, 2, 2, 186, 187, 3, 2, 2, 2, 187,
+	189, 5, 12, 7, 2, 188, 180, 3, 2, 2, 2, 189, 192, 3, 2, 2, 2, 190, 188,
+	3, 2, 2, 2, 190, 191, 3, 2, 2, 2, 191, 11, 3, 2, 2, 2, 192, 190, 3, 2,
+	2, 2, 193, 223, 5, 14, 8, 2, 194, 223, 5, 16, 9, 2, 195, 223, 5, 18, 10,
+	2, 196, 223, 5, 20, 11, 2, 197, 223, 5, 26, 14, 2, 198, 223, 5, 28, 15,
+	2, 199, 223, 5, 30, 16, 2, 200, 223, 5, 32, 17, 2, 201, 223, 5, 34, 18,
+	2, 202, 223, 5, 36, 19, 2, 203, 223, 5, 38, 20, 2, 204, 223, 5, 40, 21,
+	2, 205, 223, 5, 42, 22, 2, 206, 223, 5, 44, 23, 2, 207, 223, 5, 50, 26,
+	2, 208, 223, 5, 56, 29, 2, 209, 223, 5, 58, 30, 2, 210, 223, 5, 60, 31,
+	2, 211, 223, 5, 62, 32, 2, 212, 223, 5, 64, 33, 2, 213, 223, 5, 66, 34,
+	2, 214, 223, 5, 68, 35, 2, 215, 223, 5, 70, 36, 2, 216, 223, 5, 72, 37,
+	2, 217, 223, 5, 76, 39, 2, 218, 223, 5, 84, 43, 2, 219, 223, 5, 90, 46,
+	2, 220, 223, 5, 92, 47, 2, 221, 223, 5, 94, 48, 2, 222, 193, 3, 2, 2, 2,
+	222, 194, 3, 2, 2, 2, 222, 195, 3, 2, 2, 2, 222, 196, 3, 2, 2, 2, 222,
+	197, 3, 2, 2, 2, 222, 198, 3, 2, 2, 2, 222, 199, 3, 2, 2, 2, 222, 200,
+	3, 2, 2, 2, 222, 201, 3, 2, 2, 2, 222, 202, 3, 2, 2, 2, 222, 203, 3, 2,
+	2, 2, 222, 204, 3, 2, 2, 2, 222, 205, 3, 2, 2, 2, 222, 206, 3, 2, 2, 2,
+	222, 207, 3, 2, 2, 2, 222, 208, 3, 2, 2, 2, 222, 209, 3, 2, 2, 2, 222,
+	210, 3, 2, 2, 2, 222, 211, 3, 2, 2, 2, 222, 212, 3, 2, 2, 2, 222, 213,
+	3, 2, 2, 2, 222, 214, 3, 2, 2, 2, 222, 215, 3, 2, 2, 2, 222, 216, 3, 2,
+	2, 2, 222, 217, 3, 2, 2, 2, 222, 218, 3, 2, 2, 2, 222, 219, 3, 2, 2, 2,
+	222, 220, 3, 2, 2, 2, 222, 221, 3, 2, 2, 2, 223, 13, 3, 2, 2, 2, 224, 227,
+	7, 6, 2, 2, 225, 226, 7, 40, 2, 2, 226, 228, 5, 100, 51, 2, 227, 225, 3,
+	2, 2, 2, 227, 228, 3, 2, 2, 2, 228, 15, 3, 2, 2, 2, 229, 232, 7, 7, 2,
+	2, 230, 231, 7, 40, 2, 2, 231, 233, 5, 100, 51, 2, 232, 230, 3, 2, 2, 2,
+	232, 233, 3, 2, 2, 2, 233, 17, 3, 2, 2, 2, 234, 237, 7, 8, 2, 2, 235, 236,
+	7, 40, 2, 2, 236, 238, 5, 100, 51, 2, 237, 235, 3, 2, 2, 2, 237, 238, 3,
+	2, 2, 2, 238, 19, 3, 2, 2, 2, 239, 242, 5, 24, 13, 2, 240, 242, 5, 22,
+	12, 2, 241, 239, 3, 2, 2, 2, 241, 240, 3, 2, 2, 2, 242, 21, 3, 2, 2, 2,
+	243, 246, 7, 10, 2, 2, 244, 245, 7, 40, 2, 2, 245, 247, 5, 100, 51, 2,
+	246, 244, 3, 2, 2, 2, 246, 247, 3, 2, 2, 2, 247, 23, 3, 2, 2, 2, 248, 251,
+	7, 9, 2, 2, 249, 250, 7, 40, 2, 2, 250, 252, 5, 100, 51, 2, 251, 249, 3,
+	2, 2, 2, 251, 252, 3, 2, 2, 2, 252, 25, 3, 2, 2, 2, 253, 256, 7, 11, 2,
+	2, 254, 255, 7, 40, 2, 2, 255, 257, 5, 98, 50, 2, 256, 254, 3, 2, 2, 2,
+	256, 257, 3, 2, 2, 2, 257, 27, 3, 2, 2, 2, 258, 261, 7, 17, 2, 2, 259,
+	260, 7, 40, 2, 2, 260, 262, 5, 100, 51, 2, 261, 259, 3, 2, 2, 2, 261, 262,
+	3, 2, 2, 2, 262, 29, 3, 2, 2, 2, 263, 266, 7, 18, 2, 2, 264, 265, 7, 40,
+	2, 2, 265, 267, 5, 100, 51, 2, 266, 264, 3, 2, 2, 2, 266, 267, 3, 2, 2,
+	2, 267, 31, 3, 2, 2, 2, 268, 271, 7, 19, 2, 2, 269, 270, 7, 40, 2, 2, 270,
+	272, 5, 100, 51, 2, 271, 269, 3, 2, 2, 2, 271, 272, 3, 2, 2, 2, 272, 33,
+	3, 2, 2, 2, 273, 276, 7, 20, 2, 2, 274, 275, 7, 40, 2, 2, 275, 277, 5,
+	98, 50, 2, 276, 274, 3, 2, 2, 2, 276, 277, 3, 2, 2, 2, 277, 35, 3, 2, 2,
+	2, 278, 281, 7, 21, 2, 2, 279, 280, 7, 40, 2, 2, 280, 282, 5, 98, 50, 2,
+	281, 279, 3, 2, 2, 2, 281, 282, 3, 2, 2, 2, 282, 37, 3, 2, 2, 2, 283, 286,
+	7, 12, 2, 2, 284, 285, 7, 40, 2, 2, 285, 287, 5, 100, 51, 2, 286, 284,
+	3, 2, 2, 2, 286, 287, 3, 2, 2, 2, 287, 39, 3, 2, 2, 2, 288, 291, 7, 13,
+	2, 2, 289, 290, 7, 40, 2, 2, 290, 292, 5, 98, 50, 2, 291, 289, 3, 2, 2,
+	2, 291, 292, 3, 2, 2, 2, 292, 41, 3, 2, 2, 2, 293, 294, 7, 14, 2, 2, 294,
+	295, 7, 40, 2, 2, 295, 300, 5, 46, 24, 2, 296, 298, 7, 40, 2, 2, 297, 296,
+	3, 2, 2, 2, 297, 298, 3, 2, 2, 2, 298, 299, 3, 2, 2, 2, 299, 301, 7, 42,
+	2, 2, 300, 297, 3, 2, 2, 2, 300, 301, 3, 2, 2, 2, 301, 306, 3, 2, 2, 2,
+	302, 304, 7, 40, 2, 2, 303, 302, 3, 2, 2, 2, 303, 304, 3, 2, 2, 2, 304,
+	305, 3, 2, 2, 2, 305, 307, 5, 48, 25, 2, 306, 303, 3, 2, 2, 2, 306, 307,
+	3, 2, 2, 2, 307, 43, 3, 2, 2, 2, 308, 309, 7, 15, 2, 2, 309, 310, 7, 40,
+	2, 2, 310, 322, 5, 46, 24, 2, 311, 313, 7, 40, 2, 2, 312, 311, 3, 2, 2,
+	2, 312, 313, 3, 2, 2, 2, 313, 314, 3, 2, 2, 2, 314, 315, 7, 42, 2, 2, 315,
+	320, 3, 2, 2, 2, 316, 318, 7, 40, 2, 2, 317, 316, 3, 2, 2, 2, 317, 318,
+	3, 2, 2, 2, 318, 319, 3, 2, 2, 2, 319, 321, 5, 48, 25, 2, 320, 317, 3,
+	2, 2, 2, 320, 321, 3, 2, 2, 2, 321, 323, 3, 2, 2, 2, 322, 312, 3, 2, 2,
+	2, 322, 323, 3, 2, 2, 2, 323, 45, 3, 2, 2, 2, 324, 325, 7, 41, 2, 2, 325,
+	47, 3, 2, 2, 2, 326, 333, 7, 41, 2, 2, 327, 329, 7, 40, 2, 2, 328, 327,
+	3, 2, 2, 2, 328, 329, 3, 2, 2, 2, 329, 330, 3, 2, 2, 2, 330, 332, 7, 41,
+	2, 2, 331, 328, 3, 2, 2, 2, 332, 335, 3, 2, 2, 2, 333, 331, 3, 2, 2, 2,
+	333, 334, 3, 2, 2, 2, 334, 49, 3, 2, 2, 2, 335, 333, 3, 2, 2, 2, 336, 350,
+	7, 16, 2, 2, 337, 338, 7, 40, 2, 2, 338, 340, 5, 52, 27, 2, 339, 341, 7,
+	40, 2, 2, 340, 339, 3, 2, 2, 2, 340, 341, 3, 2, 2, 2, 341, 342, 3, 2, 2,
+	2, 342, 344, 7, 42, 2, 2, 343, 345, 7, 40, 2, 2, 344, 343, 3, 2, 2, 2,
+	344, 345, 3, 2, 2, 2, 345, 346, 3, 2, 2, 2, 346, 347, 5, 54, 28, 2, 347,
+	349, 3, 2, 2, 2, 348, 337, 3, 2, 2, 2, 349, 352, 3, 2, 2, 2, 350, 348,
+	3, 2, 2, 2, 350, 351, 3, 2, 2, 2, 351, 51, 3, 2, 2, 2, 352, 350, 3, 2,
+	2, 2, 353, 354, 7, 41, 2, 2, 354, 53, 3, 2, 2, 2, 355, 356, 7, 41, 2, 2,
+	356, 55, 3, 2, 2, 2, 357, 360, 7, 22, 2, 2, 358, 359, 7, 40, 2, 2, 359,
+	361, 5, 100, 51, 2, 360, 358, 3, 2, 2, 2, 360, 361, 3, 2, 2, 2, 361, 57,
+	3, 2, 2, 2, 362, 365, 7, 23, 2, 2, 363, 364, 7, 40, 2, 2, 364, 366, 5,
+	100, 51, 2, 365, 363, 3, 2, 2, 2, 365, 366, 3, 2, 2, 2, 366, 59, 3, 2,
+	2, 2, 367, 370, 7, 24, 2, 2, 368, 369, 7, 40, 2, 2, 369, 371, 5, 100, 51,
+	2, 370, 368, 3, 2, 2, 2, 370, 371, 3, 2, 2, 2, 371, 61, 3, 2, 2, 2, 372,
+	375, 7, 25, 2, 2, 373, 374, 7, 40, 2, 2, 374, 376, 5, 100, 51, 2, 375,
+	373, 3, 2, 2, 2, 375, 376, 3, 2, 2, 2, 376, 63, 3, 2, 2, 2, 377, 380, 7,
+	26, 2, 2, 378, 379, 7, 40, 2, 2, 379, 381, 5, 100, 51, 2, 380, 378, 3,
+	2, 2, 2, 380, 381, 3, 2, 2, 2, 381, 65, 3, 2, 2, 2, 382, 385, 7, 27, 2,
+	2, 383, 384, 7, 40, 2, 2, 384, 386, 5, 100, 51, 2, 385, 383, 3, 2, 2, 2,
+	385, 386, 3, 2, 2, 2, 386, 67, 3, 2, 2, 2, 387, 390, 7, 28, 2, 2, 388,
+	389, 7, 40, 2, 2, 389, 391, 5, 100, 51, 2, 390, 388, 3, 2, 2, 2, 390, 391,
+	3, 2, 2, 2, 391, 69, 3, 2, 2, 2, 392, 395, 7, 29, 2, 2, 393, 394, 7, 40,
+	2, 2, 394, 396, 5, 100, 51, 2, 395, 393, 3, 2, 2, 2, 395, 396, 3, 2, 2,
+	2, 396, 71, 3, 2, 2, 2, 397, 399, 5, 74, 38, 2, 398, 400, 7, 39, 2, 2,
+	399, 398, 3, 2, 2, 2, 400, 401, 3, 2, 2, 2, 401, 399, 3, 2, 2, 2, 401,
+	402, 3, 2, 2, 2, 402, 404, 3, 2, 2, 2, 403, 405, 7, 40, 2, 2, 404, 403,
+	3, 2, 2, 2, 404, 405, 3, 2, 2, 2, 405, 406, 3, 2, 2, 2, 406, 407, 7, 31,
+	2, 2, 407, 73, 3, 2, 2, 2, 408, 411, 7, 30, 2, 2, 409, 410, 7, 40, 2, 2,
+	410, 412, 5, 100, 51, 2, 411, 409, 3, 2, 2, 2, 411, 412, 3, 2, 2, 2, 412,
+	419, 3, 2, 2, 2, 413, 415, 7, 39, 2, 2, 414, 413, 3, 2, 2, 2, 415, 416,
+	3, 2, 2, 2, 416, 414, 3, 2, 2, 2, 416, 417, 3, 2, 2, 2, 417, 418, 3, 2,
+	2, 2, 418, 420, 5, 88, 45, 2, 419, 414, 3, 2, 2, 2, 419, 420, 3, 2, 2,
+	2, 420, 75, 3, 2, 2, 2, 421, 433, 5, 78, 40, 2, 422, 424, 7, 39, 2, 2,
+	423, 422, 3, 2, 2, 2, 424, 425, 3, 2, 2, 2, 425, 423, 3, 2, 2, 2, 425,
+	426, 3, 2, 2, 2, 426, 428, 3, 2, 2, 2, 427, 429, 7, 40, 2, 2, 428, 427,
+	3, 2, 2, 2, 428, 429, 3, 2, 2, 2, 429, 430, 3, 2, 2, 2, 430, 432, 5, 80,
+	41, 2, 431, 423, 3, 2, 2, 2, 432, 435, 3, 2, 2, 2, 433, 431, 3, 2, 2, 2,
+	433, 434, 3, 2, 2, 2, 434, 445, 3, 2, 2, 2, 435, 433, 3, 2, 2, 2, 436,
+	438, 7, 39, 2, 2, 437, 436, 3, 2, 2, 2, 438, 439, 3, 2, 2, 2, 439, 437,
+	3, 2, 2, 2, 439, 440, 3, 2, 2, 2, 440, 442, 3, 2, 2, 2, 441, 443, 7, 40,
+	2, 2, 442, 441, 3, 2, 2, 2, 442, 443, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2,
+	444, 446, 5, 82, 42, 2, 445, 437, 3, 2, 2, 2, 445, 446, 3, 2, 2, 2, 446,
+	448, 3, 2, 2, 2, 447, 449, 7, 39, 2, 2, 448, 447, 3, 2, 2, 2, 449, 450,
+	3, 2, 2, 2, 450, 448, 3, 2, 2, 2, 450, 451, 3, 2, 2, 2, 451, 453, 3, 2,
+	2, 2, 452, 454, 7, 40, 2, 2, 453, 452, 3, 2, 2, 2, 453, 454, 3, 2, 2, 2,
+	454, 455, 3, 2, 2, 2, 455, 456, 7, 31, 2, 2, 456, 77, 3, 2, 2, 2, 457,
+	460, 7, 34, 2, 2, 458, 459, 7, 40, 2, 2, 459, 461, 5, 100, 51, 2, 460,
+	458, 3, 2, 2, 2, 460, 461, 3, 2, 2, 2, 461, 468, 3, 2, 2, 2, 462, 464,
+	7, 39, 2, 2, 463, 462, 3, 2, 2, 2, 464, 465, 3, 2, 2, 2, 465, 463, 3, 2,
+	2, 2, 465, 466, 3, 2, 2, 2, 466, 467, 3, 2, 2, 2, 467, 469, 5, 88, 45,
+	2, 468, 463, 3, 2, 2, 2, 468, 469, 3, 2, 2, 2, 469, 79, 3, 2, 2, 2, 470,
+	473, 7, 35, 2, 2, 471, 472, 7, 40, 2, 2, 472, 474, 5, 100, 51, 2, 473,
+	471, 3, 2, 2, 2, 473, 474, 3, 2, 2, 2, 474, 481, 3, 2, 2, 2, 475, 477,
+	7, 39, 2, 2, 476, 475, 3, 2, 2, 2, 477, 478, 3, 2, 2, 2, 478, 476, 3, 2,
+	2, 2, 478, 479, 3, 2, 2, 2, 479, 480, 3, 2, 2, 2, 480, 482, 5, 88, 45,
+	2, 481, 476, 3, 2, 2, 2, 481, 482, 3, 2, 2, 2, 482, 81, 3, 2, 2, 2, 483,
+	490, 7, 36, 2, 2, 484, 486, 7, 39, 2, 2, 485, 484, 3, 2, 2, 2, 486, 487,
+	3, 2, 2, 2, 487, 485, 3, 2, 2, 2, 487, 488, 3, 2, 2, 2, 488, 489, 3, 2,
+	2, 2, 489, 491, 5, 88, 45, 2, 490, 485, 3, 2, 2, 2, 490, 491, 3, 2, 2,
+	2, 491, 83, 3, 2, 2, 2, 492, 494, 5, 86, 44, 2, 493, 495, 7, 39, 2, 2,
+	494, 493, 3, 2, 2, 2, 495, 496, 3, 2, 2, 2, 496, 494, 3, 2, 2, 2, 496,
+	497, 3, 2, 2, 2, 497, 499, 3, 2, 2, 2, 498, 500, 7, 40, 2, 2, 499, 498,
+	3, 2, 2, 2, 499, 500, 3, 2, 2, 2, 500, 501, 3, 2, 2, 2, 501, 502, 7, 31,
+	2, 2, 502, 85, 3, 2, 2, 2, 503, 506, 7, 37, 2, 2, 504, 505, 7, 40, 2, 2,
+	505, 507, 5, 100, 51, 2, 506, 504, 3, 2, 2, 2, 506, 507, 3, 2, 2, 2, 507,
+	514, 3, 2, 2, 2, 508, 510, 7, 39, 2, 2, 509, 508, 3, 2, 2, 2, 510, 511,
+	3, 2, 2, 2, 511, 509, 3, 2, 2, 2, 511, 512, 3, 2, 2, 2, 512, 513, 3, 2,
+	2, 2, 513, 515, 5, 88, 45, 2, 514, 509, 3, 2, 2, 2, 514, 515, 3, 2, 2,
+	2, 515, 87, 3, 2, 2, 2, 516, 517, 5, 10, 6, 2, 517, 89, 3, 2, 2, 2, 518,
+	521, 7, 32, 2, 2, 519, 520, 7, 40, 2, 2, 520, 522, 5, 100, 51, 2, 521,
+	519, 3, 2, 2, 2, 521, 522, 3, 2, 2, 2, 522, 91, 3, 2, 2, 2, 523, 526, 7,
+	33, 2, 2, 524, 525, 7, 40, 2, 2, 525, 527, 5, 100, 51, 2, 526, 524, 3,
+	2, 2, 2, 526, 527, 3, 2, 2, 2, 527, 93, 3, 2, 2, 2, 528, 531, 5, 96, 49,
+	2, 529, 530, 7, 40, 2, 2, 530, 532, 5, 100, 51, 2, 531, 529, 3, 2, 2, 2,
+	531, 532, 3, 2, 2, 2, 532, 95, 3, 2, 2, 2, 533, 534, 7, 38, 2, 2, 534,
+	97, 3, 2, 2, 2, 535, 536, 5, 100, 51, 2, 536, 99, 3, 2, 2, 2, 537, 544,
+	5, 102, 52, 2, 538, 540, 7, 40, 2, 2, 539, 538, 3, 2, 2, 2, 539, 540, 3,
+	2, 2, 2, 540, 541, 3, 2, 2, 2, 541, 543, 5, 102, 52, 2, 542, 539, 3, 2,
+	2, 2, 543, 546, 3, 2, 2, 2, 544, 542, 3, 2, 2, 2, 544, 545, 3, 2, 2, 2,
+	545, 101, 3, 2, 2, 2, 546, 544, 3, 2, 2, 2, 547, 548, 7, 41, 2, 2, 548,
+	103, 3, 2, 2, 2, 87, 107, 113, 118, 122, 127, 134, 139, 144, 148, 154,
+	158, 164, 167, 171, 176, 182, 185, 190, 222, 227, 232, 237, 241, 246, 251,
+	256, 261, 266, 271, 276, 281, 286, 291, 297, 300, 303, 306, 312, 317, 320,
+	322, 328, 333, 340, 344, 350, 360, 365, 370, 375, 380, 385, 390, 395, 401,
+	404, 411, 416, 419, 425, 428, 433, 439, 442, 445, 450, 453, 460, 465, 468,
+	473, 478, 481, 487, 490, 496, 499, 506, 511, 514, 521, 526, 531, 539, 544,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
@@ -213,14 +283,16 @@ var literalNames = []string{
 	"'SAVE IMAGE'", "'RUN'", "'EXPOSE'", "'VOLUME'", "'ENV'", "'ARG'", "'LABEL'",
 	"'BUILD'", "'WORKDIR'", "'USER'", "'CMD'", "'ENTRYPOINT'", "'GIT CLONE'",
 	"'DOCKER LOAD'", "'DOCKER PULL'", "'ADD'", "'STOPSIGNAL'", "'ONBUILD'",
-	"'HEALTHCHECK'", "'SHELL'", "'WITH DOCKER'", "'END'",
+	"'HEALTHCHECK'", "'SHELL'", "'WITH DOCKER'", "'END'", "'COMMAND'", "'DO'",
+	"'IF'", "'ELSE IF'", "'ELSE'", "'FOR'",
 }
 var symbolicNames = []string{
 	"", "INDENT", "DEDENT", "Target", "FROM", "FROM_DOCKERFILE", "COPY", "SAVE_ARTIFACT",
 	"SAVE_IMAGE", "RUN", "EXPOSE", "VOLUME", "ENV", "ARG", "LABEL", "BUILD",
 	"WORKDIR", "USER", "CMD", "ENTRYPOINT", "GIT_CLONE", "DOCKER_LOAD", "DOCKER_PULL",
 	"ADD", "STOPSIGNAL", "ONBUILD", "HEALTHCHECK", "SHELL", "WITH_DOCKER",
-	"END", "Command", "NL", "WS", "Atom", "EQUALS",
+	"END", "COMMAND", "DO", "IF", "ELSE_IF", "ELSE", "FOR", "Command", "NL",
+	"WS", "Atom", "EQUALS",
 }
 
 var ruleNames = []string{
@@ -230,8 +302,10 @@ var ruleNames = []string{
 	"exposeStmt", "volumeStmt", "envStmt", "argStmt", "envArgKey", "envArgValue",
 	"labelStmt", "labelKey", "labelValue", "gitCloneStmt", "dockerLoadStmt",
 	"dockerPullStmt", "addStmt", "stopsignalStmt", "onbuildStmt", "healthcheckStmt",
-	"shellStmt", "withDockerStmt", "endStmt", "genericCommandStmt", "commandName",
-	"stmtWordsMaybeJSON", "stmtWords", "stmtWord",
+	"shellStmt", "withDockerStmt", "withDockerClause", "ifStmt", "ifClause",
+	"elseIfClause", "elseClause", "forStmt", "forClause", "block", "commandStmt",
+	"doStmt", "genericCommandStmt", "commandName", "stmtWordsMaybeJSON", "stmtWords",
+	"stmtWord",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
 
@@ -291,11 +365,17 @@ const (
 	EarthParserSHELL           = 27
 	EarthParserWITH_DOCKER     = 28
 	EarthParserEND             = 29
-	EarthParserCommand         = 30
-	EarthParserNL              = 31
-	EarthParserWS              = 32
-	EarthParserAtom            = 33
-	EarthParserEQUALS          = 34
+	EarthParserCOMMAND         = 30
+	EarthParserDO              = 31
+	EarthParserIF              = 32
+	EarthParserELSE_IF         = 33
+	EarthParserELSE            = 34
+	EarthParserFOR             = 35
+	EarthParserCommand         = 36
+	EarthParserNL              = 37
+	EarthParserWS              = 38
+	EarthParserAtom            = 39
+	EarthParserEQUALS          = 40
 )
 
 // EarthParser rules.
@@ -336,12 +416,21 @@ const (
 	EarthParserRULE_healthcheckStmt    = 33
 	EarthParserRULE_shellStmt          = 34
 	EarthParserRULE_withDockerStmt     = 35
-	EarthParserRULE_endStmt            = 36
-	EarthParserRULE_genericCommandStmt = 37
-	EarthParserRULE_commandName        = 38
-	EarthParserRULE_stmtWordsMaybeJSON = 39
-	EarthParserRULE_stmtWords          = 40
-	EarthParserRULE_stmtWord           = 41
+	EarthParserRULE_withDockerClause   = 36
+	EarthParserRULE_ifStmt             = 37
+	EarthParserRULE_ifClause           = 38
+	EarthParserRULE_elseIfClause       = 39
+	EarthParserRULE_elseClause         = 40
+	EarthParserRULE_forStmt            = 41
+	EarthParserRULE_forClause          = 42
+	EarthParserRULE_block              = 43
+	EarthParserRULE_commandStmt        = 44
+	EarthParserRULE_doStmt             = 45
+	EarthParserRULE_genericCommandStmt = 46
+	EarthParserRULE_commandName        = 47
+	EarthParserRULE_stmtWordsMaybeJSON = 48
+	EarthParserRULE_stmtWords          = 49
+	EarthParserRULE_stmtWord           = 50
 )
 
 // IEarthFileContext is an interface to support dynamic dispatch.
@@ -458,80 +547,80 @@ func (p *EarthParser) EarthFile() (localctx IEarthFileContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(87)
+	p.SetState(105)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(84)
+				p.SetState(102)
 				p.Match(EarthParserNL)
 			}
 
 		}
-		p.SetState(89)
+		p.SetState(107)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
 	}
-	p.SetState(93)
+	p.SetState(111)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if ((_la-4)&-(0x1f+1)) == 0 && ((1<<uint((_la-4)))&((1<<(EarthParserFROM-4))|(1<<(EarthParserFROM_DOCKERFILE-4))|(1<<(EarthParserCOPY-4))|(1<<(EarthParserSAVE_ARTIFACT-4))|(1<<(EarthParserSAVE_IMAGE-4))|(1<<(EarthParserRUN-4))|(1<<(EarthParserEXPOSE-4))|(1<<(EarthParserVOLUME-4))|(1<<(EarthParserENV-4))|(1<<(EarthParserARG-4))|(1<<(EarthParserLABEL-4))|(1<<(EarthParserBUILD-4))|(1<<(EarthParserWORKDIR-4))|(1<<(EarthParserUSER-4))|(1<<(EarthParserCMD-4))|(1<<(EarthParserENTRYPOINT-4))|(1<<(EarthParserGIT_CLONE-4))|(1<<(EarthParserDOCKER_LOAD-4))|(1<<(EarthParserDOCKER_PULL-4))|(1<<(EarthParserADD-4))|(1<<(EarthParserSTOPSIGNAL-4))|(1<<(EarthParserONBUILD-4))|(1<<(EarthParserHEALTHCHECK-4))|(1<<(EarthParserSHELL-4))|(1<<(EarthParserWITH_DOCKER-4))|(1<<(EarthParserEND-4))|(1<<(EarthParserCommand-4))|(1<<(EarthParserWS-4)))) != 0 {
+	if ((_la&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<EarthParserFROM)|(1<<EarthParserFROM_DOCKERFILE)|(1<<EarthParserCOPY)|(1<<EarthParserSAVE_ARTIFACT)|(1<<EarthParserSAVE_IMAGE)|(1<<EarthParserRUN)|(1<<EarthParserEXPOSE)|(1<<EarthParserVOLUME)|(1<<EarthParserENV)|(1<<EarthParserARG)|(1<<EarthParserLABEL)|(1<<EarthParserBUILD)|(1<<EarthParserWORKDIR)|(1<<EarthParserUSER)|(1<<EarthParserCMD)|(1<<EarthParserENTRYPOINT)|(1<<EarthParserGIT_CLONE)|(1<<EarthParserDOCKER_LOAD)|(1<<EarthParserDOCKER_PULL)|(1<<EarthParserADD)|(1<<EarthParserSTOPSIGNAL)|(1<<EarthParserONBUILD)|(1<<EarthParserHEALTHCHECK)|(1<<EarthParserSHELL)|(1<<EarthParserWITH_DOCKER)|(1<<EarthParserCOMMAND)|(1<<EarthParserDO))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(EarthParserIF-32))|(1<<(EarthParserFOR-32))|(1<<(EarthParserCommand-32))|(1<<(EarthParserWS-32)))) != 0) {
 		{
-			p.SetState(90)
+			p.SetState(108)
 			p.Stmts()
 		}
 		{
-			p.SetState(91)
+			p.SetState(109)
 			p.Match(EarthParserNL)
 		}
 
 	}
-	p.SetState(98)
+	p.SetState(116)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(95)
+				p.SetState(113)
 				p.Match(EarthParserNL)
 			}
 
 		}
-		p.SetState(100)
+		p.SetState(118)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext())
 	}
-	p.SetState(102)
+	p.SetState(120)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == EarthParserTarget {
 		{
-			p.SetState(101)
+			p.SetState(119)
 			p.Targets()
 		}
 
 	}
-	p.SetState(107)
+	p.SetState(125)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == EarthParserNL {
 		{
-			p.SetState(104)
+			p.SetState(122)
 			p.Match(EarthParserNL)
 		}
 
-		p.SetState(109)
+		p.SetState(127)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(110)
+		p.SetState(128)
 		p.Match(EarthParserEOF)
 	}
 
@@ -668,88 +757,88 @@ func (p *EarthParser) Targets() (localctx ITargetsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(112)
+		p.SetState(130)
 		p.Target()
 	}
-	p.SetState(114)
+	p.SetState(132)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == EarthParserWS {
 		{
-			p.SetState(113)
+			p.SetState(131)
 			p.Match(EarthParserWS)
 		}
 
 	}
-	p.SetState(128)
+	p.SetState(146)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(117)
+			p.SetState(135)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for ok := true; ok; ok = _la == EarthParserNL {
 				{
-					p.SetState(116)
+					p.SetState(134)
 					p.Match(EarthParserNL)
 				}
 
-				p.SetState(119)
+				p.SetState(137)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
 			{
-				p.SetState(121)
+				p.SetState(139)
 				p.Match(EarthParserDEDENT)
 			}
 			{
-				p.SetState(122)
+				p.SetState(140)
 				p.Target()
 			}
-			p.SetState(124)
+			p.SetState(142)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == EarthParserWS {
 				{
-					p.SetState(123)
+					p.SetState(141)
 					p.Match(EarthParserWS)
 				}
 
 			}
 
 		}
-		p.SetState(130)
+		p.SetState(148)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext())
 	}
-	p.SetState(134)
+	p.SetState(152)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(131)
+				p.SetState(149)
 				p.Match(EarthParserNL)
 			}
 
 		}
-		p.SetState(136)
+		p.SetState(154)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 9, p.GetParserRuleContext())
 	}
-	p.SetState(138)
+	p.SetState(156)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == EarthParserDEDENT {
 		{
-			p.SetState(137)
+			p.SetState(155)
 			p.Match(EarthParserDEDENT)
 		}
 
@@ -875,44 +964,44 @@ func (p *EarthParser) Target() (localctx ITargetContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(140)
+		p.SetState(158)
 		p.TargetHeader()
 	}
-	p.SetState(142)
+	p.SetState(160)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == EarthParserNL {
 		{
-			p.SetState(141)
+			p.SetState(159)
 			p.Match(EarthParserNL)
 		}
 
-		p.SetState(144)
+		p.SetState(162)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(147)
+	p.SetState(165)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == EarthParserWS {
 		{
-			p.SetState(146)
+			p.SetState(164)
 			p.Match(EarthParserWS)
 		}
 
 	}
 	{
-		p.SetState(149)
+		p.SetState(167)
 		p.Match(EarthParserINDENT)
 	}
-	p.SetState(151)
+	p.SetState(169)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(150)
+			p.SetState(168)
 			p.Stmts()
 		}
 
@@ -1005,7 +1094,7 @@ func (p *EarthParser) TargetHeader() (localctx ITargetHeaderContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(153)
+		p.SetState(171)
 		p.Match(EarthParserTarget)
 	}
 
@@ -1133,59 +1222,59 @@ func (p *EarthParser) Stmts() (localctx IStmtsContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(156)
+	p.SetState(174)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == EarthParserWS {
 		{
-			p.SetState(155)
+			p.SetState(173)
 			p.Match(EarthParserWS)
 		}
 
 	}
 	{
-		p.SetState(158)
+		p.SetState(176)
 		p.Stmt()
 	}
-	p.SetState(170)
+	p.SetState(188)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(160)
+			p.SetState(178)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			for ok := true; ok; ok = _la == EarthParserNL {
 				{
-					p.SetState(159)
+					p.SetState(177)
 					p.Match(EarthParserNL)
 				}
 
-				p.SetState(162)
+				p.SetState(180)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 			}
-			p.SetState(165)
+			p.SetState(183)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == EarthParserWS {
 				{
-					p.SetState(164)
+					p.SetState(182)
 					p.Match(EarthParserWS)
 				}
 
 			}
 			{
-				p.SetState(167)
+				p.SetState(185)
 				p.Stmt()
 			}
 
 		}
-		p.SetState(172)
+		p.SetState(190)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
 	}
@@ -1471,14 +1560,44 @@ func (s *StmtContext) WithDockerStmt() IWithDockerStmtContext {
 	return t.(IWithDockerStmtContext)
 }
 
-func (s *StmtContext) EndStmt() IEndStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEndStmtContext)(nil)).Elem(), 0)
+func (s *StmtContext) IfStmt() IIfStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStmtContext)
+}
+
+func (s *StmtContext) ForStmt() IForStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForStmtContext)
+}
+
+func (s *StmtContext) CommandStmt() ICommandStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICommandStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICommandStmtContext)
+}
+
+func (s *StmtContext) DoStmt() IDoStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDoStmtContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IEndStmtContext)
+	return t.(IDoStmtContext)
 }
 
 func (s *StmtContext) GenericCommandStmt() IGenericCommandStmtContext {
@@ -1531,189 +1650,210 @@ func (p *EarthParser) Stmt() (localctx IStmtContext) {
 		}
 	}()
 
-	p.SetState(199)
+	p.SetState(220)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case EarthParserFROM:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(173)
+			p.SetState(191)
 			p.FromStmt()
 		}
 
 	case EarthParserFROM_DOCKERFILE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(174)
+			p.SetState(192)
 			p.FromDockerfileStmt()
 		}
 
 	case EarthParserCOPY:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(175)
+			p.SetState(193)
 			p.CopyStmt()
 		}
 
 	case EarthParserSAVE_ARTIFACT, EarthParserSAVE_IMAGE:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(176)
+			p.SetState(194)
 			p.SaveStmt()
 		}
 
 	case EarthParserRUN:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(177)
+			p.SetState(195)
 			p.RunStmt()
 		}
 
 	case EarthParserBUILD:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(178)
+			p.SetState(196)
 			p.BuildStmt()
 		}
 
 	case EarthParserWORKDIR:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(179)
+			p.SetState(197)
 			p.WorkdirStmt()
 		}
 
 	case EarthParserUSER:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(180)
+			p.SetState(198)
 			p.UserStmt()
 		}
 
 	case EarthParserCMD:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(181)
+			p.SetState(199)
 			p.CmdStmt()
 		}
 
 	case EarthParserENTRYPOINT:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(182)
+			p.SetState(200)
 			p.EntrypointStmt()
 		}
 
 	case EarthParserEXPOSE:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(183)
+			p.SetState(201)
 			p.ExposeStmt()
 		}
 
 	case EarthParserVOLUME:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(184)
+			p.SetState(202)
 			p.VolumeStmt()
 		}
 
 	case EarthParserENV:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(185)
+			p.SetState(203)
 			p.EnvStmt()
 		}
 
 	case EarthParserARG:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(186)
+			p.SetState(204)
 			p.ArgStmt()
 		}
 
 	case EarthParserLABEL:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(187)
+			p.SetState(205)
 			p.LabelStmt()
 		}
 
 	case EarthParserGIT_CLONE:
 		p.EnterOuterAlt(localctx, 16)
 		{
-			p.SetState(188)
+			p.SetState(206)
 			p.GitCloneStmt()
 		}
 
 	case EarthParserDOCKER_LOAD:
 		p.EnterOuterAlt(localctx, 17)
 		{
-			p.SetState(189)
+			p.SetState(207)
 			p.DockerLoadStmt()
 		}
 
 	case EarthParserDOCKER_PULL:
 		p.EnterOuterAlt(localctx, 18)
 		{
-			p.SetState(190)
+			p.SetState(208)
 			p.DockerPullStmt()
 		}
 
 	case EarthParserADD:
 		p.EnterOuterAlt(localctx, 19)
 		{
-			p.SetState(191)
+			p.SetState(209)
 			p.AddStmt()
 		}
 
 	case EarthParserSTOPSIGNAL:
 		p.EnterOuterAlt(localctx, 20)
 		{
-			p.SetState(192)
+			p.SetState(210)
 			p.StopsignalStmt()
 		}
 
 	case EarthParserONBUILD:
 		p.EnterOuterAlt(localctx, 21)
 		{
-			p.SetState(193)
+			p.SetState(211)
 			p.OnbuildStmt()
 		}
 
 	case EarthParserHEALTHCHECK:
 		p.EnterOuterAlt(localctx, 22)
 		{
-			p.SetState(194)
+			p.SetState(212)
 			p.HealthcheckStmt()
 		}
 
 	case EarthParserSHELL:
 		p.EnterOuterAlt(localctx, 23)
 		{
-			p.SetState(195)
+			p.SetState(213)
 			p.ShellStmt()
 		}
 
 	case EarthParserWITH_DOCKER:
 		p.EnterOuterAlt(localctx, 24)
 		{
-			p.SetState(196)
+			p.SetState(214)
 			p.WithDockerStmt()
 		}
 
-	case EarthParserEND:
+	case EarthParserIF:
 		p.EnterOuterAlt(localctx, 25)
 		{
-			p.SetState(197)
-			p.EndStmt()
+			p.SetState(215)
+			p.IfStmt()
 		}
 
-	case EarthParserCommand:
+	case EarthParserFOR:
 		p.EnterOuterAlt(localctx, 26)
 		{
-			p.SetState(198)
+			p.SetState(216)
+			p.ForStmt()
+		}
+
+	case EarthParserCOMMAND:
+		p.EnterOuterAlt(localctx, 27)
+		{
+			p.SetState(217)
+			p.CommandStmt()
+		}
+
+	case EarthParserDO:
+		p.EnterOuterAlt(localctx, 28)
+		{
+			p.SetState(218)
+			p.DoStmt()
+		}
+
+	case EarthParserCommand:
+		p.EnterOuterAlt(localctx, 29)
+		{
+			p.SetState(219)
 			p.GenericCommandStmt()
 		}
 
@@ -1822,19 +1962,19 @@ func (p *EarthParser) FromStmt() (localctx IFromStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(201)
+		p.SetState(222)
 		p.Match(EarthParserFROM)
 	}
-	p.SetState(204)
+	p.SetState(225)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(202)
+			p.SetState(223)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(203)
+			p.SetState(224)
 			p.StmtWords()
 		}
 
@@ -1941,19 +2081,19 @@ func (p *EarthParser) FromDockerfileStmt() (localctx IFromDockerfileStmtContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(206)
+		p.SetState(227)
 		p.Match(EarthParserFROM_DOCKERFILE)
 	}
-	p.SetState(209)
+	p.SetState(230)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(207)
+			p.SetState(228)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(208)
+			p.SetState(229)
 			p.StmtWords()
 		}
 
@@ -2060,19 +2200,19 @@ func (p *EarthParser) CopyStmt() (localctx ICopyStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(211)
+		p.SetState(232)
 		p.Match(EarthParserCOPY)
 	}
-	p.SetState(214)
+	p.SetState(235)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(212)
+			p.SetState(233)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(213)
+			p.SetState(234)
 			p.StmtWords()
 		}
 
@@ -2179,21 +2319,21 @@ func (p *EarthParser) SaveStmt() (localctx ISaveStmtContext) {
 		}
 	}()
 
-	p.SetState(218)
+	p.SetState(239)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case EarthParserSAVE_ARTIFACT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(216)
+			p.SetState(237)
 			p.SaveArtifact()
 		}
 
 	case EarthParserSAVE_IMAGE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(217)
+			p.SetState(238)
 			p.SaveImage()
 		}
 
@@ -2302,19 +2442,19 @@ func (p *EarthParser) SaveImage() (localctx ISaveImageContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(220)
+		p.SetState(241)
 		p.Match(EarthParserSAVE_IMAGE)
 	}
-	p.SetState(223)
+	p.SetState(244)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(221)
+			p.SetState(242)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(222)
+			p.SetState(243)
 			p.StmtWords()
 		}
 
@@ -2421,19 +2561,19 @@ func (p *EarthParser) SaveArtifact() (localctx ISaveArtifactContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(225)
+		p.SetState(246)
 		p.Match(EarthParserSAVE_ARTIFACT)
 	}
-	p.SetState(228)
+	p.SetState(249)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(226)
+			p.SetState(247)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(227)
+			p.SetState(248)
 			p.StmtWords()
 		}
 
@@ -2540,19 +2680,19 @@ func (p *EarthParser) RunStmt() (localctx IRunStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(230)
+		p.SetState(251)
 		p.Match(EarthParserRUN)
 	}
-	p.SetState(233)
+	p.SetState(254)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(231)
+			p.SetState(252)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(232)
+			p.SetState(253)
 			p.StmtWordsMaybeJSON()
 		}
 
@@ -2659,19 +2799,19 @@ func (p *EarthParser) BuildStmt() (localctx IBuildStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(235)
+		p.SetState(256)
 		p.Match(EarthParserBUILD)
 	}
-	p.SetState(238)
+	p.SetState(259)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(236)
+			p.SetState(257)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(237)
+			p.SetState(258)
 			p.StmtWords()
 		}
 
@@ -2778,19 +2918,19 @@ func (p *EarthParser) WorkdirStmt() (localctx IWorkdirStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(240)
+		p.SetState(261)
 		p.Match(EarthParserWORKDIR)
 	}
-	p.SetState(243)
+	p.SetState(264)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(241)
+			p.SetState(262)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(242)
+			p.SetState(263)
 			p.StmtWords()
 		}
 
@@ -2897,19 +3037,19 @@ func (p *EarthParser) UserStmt() (localctx IUserStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(245)
+		p.SetState(266)
 		p.Match(EarthParserUSER)
 	}
-	p.SetState(248)
+	p.SetState(269)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(246)
+			p.SetState(267)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(247)
+			p.SetState(268)
 			p.StmtWords()
 		}
 
@@ -3016,19 +3156,19 @@ func (p *EarthParser) CmdStmt() (localctx ICmdStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(250)
+		p.SetState(271)
 		p.Match(EarthParserCMD)
 	}
-	p.SetState(253)
+	p.SetState(274)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(251)
+			p.SetState(272)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(252)
+			p.SetState(273)
 			p.StmtWordsMaybeJSON()
 		}
 
@@ -3135,19 +3275,19 @@ func (p *EarthParser) EntrypointStmt() (localctx IEntrypointStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(255)
+		p.SetState(276)
 		p.Match(EarthParserENTRYPOINT)
 	}
-	p.SetState(258)
+	p.SetState(279)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(256)
+			p.SetState(277)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(257)
+			p.SetState(278)
 			p.StmtWordsMaybeJSON()
 		}
 
@@ -3254,19 +3394,19 @@ func (p *EarthParser) ExposeStmt() (localctx IExposeStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(260)
+		p.SetState(281)
 		p.Match(EarthParserEXPOSE)
 	}
-	p.SetState(263)
+	p.SetState(284)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(261)
+			p.SetState(282)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(262)
+			p.SetState(283)
 			p.StmtWords()
 		}
 
@@ -3373,19 +3513,19 @@ func (p *EarthParser) VolumeStmt() (localctx IVolumeStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(265)
+		p.SetState(286)
 		p.Match(EarthParserVOLUME)
 	}
-	p.SetState(268)
+	p.SetState(289)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(266)
+			p.SetState(287)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(267)
+			p.SetState(288)
 			p.StmtWordsMaybeJSON()
 		}
 
@@ -3511,55 +3651,55 @@ func (p *EarthParser) EnvStmt() (localctx IEnvStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(270)
+		p.SetState(291)
 		p.Match(EarthParserENV)
 	}
 	{
-		p.SetState(271)
+		p.SetState(292)
 		p.Match(EarthParserWS)
 	}
 	{
-		p.SetState(272)
+		p.SetState(293)
 		p.EnvArgKey()
 	}
-	p.SetState(277)
+	p.SetState(298)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) == 1 {
-		p.SetState(274)
+		p.SetState(295)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == EarthParserWS {
 			{
-				p.SetState(273)
+				p.SetState(294)
 				p.Match(EarthParserWS)
 			}
 
 		}
 		{
-			p.SetState(276)
+			p.SetState(297)
 			p.Match(EarthParserEQUALS)
 		}
 
 	}
-	p.SetState(283)
+	p.SetState(304)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
-		p.SetState(280)
+		p.SetState(301)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == EarthParserWS {
 			{
-				p.SetState(279)
+				p.SetState(300)
 				p.Match(EarthParserWS)
 			}
 
 		}
 		{
-			p.SetState(282)
+			p.SetState(303)
 			p.EnvArgValue()
 		}
 
@@ -3685,54 +3825,54 @@ func (p *EarthParser) ArgStmt() (localctx IArgStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(285)
+		p.SetState(306)
 		p.Match(EarthParserARG)
 	}
 	{
-		p.SetState(286)
+		p.SetState(307)
 		p.Match(EarthParserWS)
 	}
 	{
-		p.SetState(287)
+		p.SetState(308)
 		p.EnvArgKey()
 	}
-	p.SetState(299)
+	p.SetState(320)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext()) == 1 {
-		p.SetState(289)
+		p.SetState(310)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == EarthParserWS {
 			{
-				p.SetState(288)
+				p.SetState(309)
 				p.Match(EarthParserWS)
 			}
 
 		}
 		{
-			p.SetState(291)
+			p.SetState(312)
 			p.Match(EarthParserEQUALS)
 		}
 
-		p.SetState(297)
+		p.SetState(318)
 		p.GetErrorHandler().Sync(p)
 
 		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext()) == 1 {
-			p.SetState(294)
+			p.SetState(315)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == EarthParserWS {
 				{
-					p.SetState(293)
+					p.SetState(314)
 					p.Match(EarthParserWS)
 				}
 
 			}
 			{
-				p.SetState(296)
+				p.SetState(317)
 				p.EnvArgValue()
 			}
 
@@ -3827,7 +3967,7 @@ func (p *EarthParser) EnvArgKey() (localctx IEnvArgKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(301)
+		p.SetState(322)
 		p.Match(EarthParserAtom)
 	}
 
@@ -3933,33 +4073,33 @@ func (p *EarthParser) EnvArgValue() (localctx IEnvArgValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(303)
+		p.SetState(324)
 		p.Match(EarthParserAtom)
 	}
-	p.SetState(310)
+	p.SetState(331)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(305)
+			p.SetState(326)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == EarthParserWS {
 				{
-					p.SetState(304)
+					p.SetState(325)
 					p.Match(EarthParserWS)
 				}
 
 			}
 			{
-				p.SetState(307)
+				p.SetState(328)
 				p.Match(EarthParserAtom)
 			}
 
 		}
-		p.SetState(312)
+		p.SetState(333)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext())
 	}
@@ -4116,56 +4256,56 @@ func (p *EarthParser) LabelStmt() (localctx ILabelStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(313)
+		p.SetState(334)
 		p.Match(EarthParserLABEL)
 	}
-	p.SetState(327)
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(314)
+				p.SetState(335)
 				p.Match(EarthParserWS)
 			}
 			{
-				p.SetState(315)
+				p.SetState(336)
 				p.LabelKey()
 			}
-			p.SetState(317)
+			p.SetState(338)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == EarthParserWS {
 				{
-					p.SetState(316)
+					p.SetState(337)
 					p.Match(EarthParserWS)
 				}
 
 			}
 			{
-				p.SetState(319)
+				p.SetState(340)
 				p.Match(EarthParserEQUALS)
 			}
-			p.SetState(321)
+			p.SetState(342)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == EarthParserWS {
 				{
-					p.SetState(320)
+					p.SetState(341)
 					p.Match(EarthParserWS)
 				}
 
 			}
 			{
-				p.SetState(323)
+				p.SetState(344)
 				p.LabelValue()
 			}
 
 		}
-		p.SetState(329)
+		p.SetState(350)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext())
 	}
@@ -4257,7 +4397,7 @@ func (p *EarthParser) LabelKey() (localctx ILabelKeyContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(330)
+		p.SetState(351)
 		p.Match(EarthParserAtom)
 	}
 
@@ -4348,7 +4488,7 @@ func (p *EarthParser) LabelValue() (localctx ILabelValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(332)
+		p.SetState(353)
 		p.Match(EarthParserAtom)
 	}
 
@@ -4453,19 +4593,19 @@ func (p *EarthParser) GitCloneStmt() (localctx IGitCloneStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(334)
+		p.SetState(355)
 		p.Match(EarthParserGIT_CLONE)
 	}
-	p.SetState(337)
+	p.SetState(358)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 46, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(335)
+			p.SetState(356)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(336)
+			p.SetState(357)
 			p.StmtWords()
 		}
 
@@ -4572,19 +4712,19 @@ func (p *EarthParser) DockerLoadStmt() (localctx IDockerLoadStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(339)
+		p.SetState(360)
 		p.Match(EarthParserDOCKER_LOAD)
 	}
-	p.SetState(342)
+	p.SetState(363)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(340)
+			p.SetState(361)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(341)
+			p.SetState(362)
 			p.StmtWords()
 		}
 
@@ -4691,19 +4831,19 @@ func (p *EarthParser) DockerPullStmt() (localctx IDockerPullStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(344)
+		p.SetState(365)
 		p.Match(EarthParserDOCKER_PULL)
 	}
-	p.SetState(347)
+	p.SetState(368)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(345)
+			p.SetState(366)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(346)
+			p.SetState(367)
 			p.StmtWords()
 		}
 
@@ -4810,19 +4950,19 @@ func (p *EarthParser) AddStmt() (localctx IAddStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(349)
+		p.SetState(370)
 		p.Match(EarthParserADD)
 	}
-	p.SetState(352)
+	p.SetState(373)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(350)
+			p.SetState(371)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(351)
+			p.SetState(372)
 			p.StmtWords()
 		}
 
@@ -4929,19 +5069,19 @@ func (p *EarthParser) StopsignalStmt() (localctx IStopsignalStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(354)
+		p.SetState(375)
 		p.Match(EarthParserSTOPSIGNAL)
 	}
-	p.SetState(357)
+	p.SetState(378)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(355)
+			p.SetState(376)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(356)
+			p.SetState(377)
 			p.StmtWords()
 		}
 
@@ -5048,19 +5188,19 @@ func (p *EarthParser) OnbuildStmt() (localctx IOnbuildStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(359)
+		p.SetState(380)
 		p.Match(EarthParserONBUILD)
 	}
-	p.SetState(362)
+	p.SetState(383)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(360)
+			p.SetState(381)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(361)
+			p.SetState(382)
 			p.StmtWords()
 		}
 
@@ -5167,19 +5307,19 @@ func (p *EarthParser) HealthcheckStmt() (localctx IHealthcheckStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(364)
+		p.SetState(385)
 		p.Match(EarthParserHEALTHCHECK)
 	}
-	p.SetState(367)
+	p.SetState(388)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(365)
+			p.SetState(386)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(366)
+			p.SetState(387)
 			p.StmtWords()
 		}
 
@@ -5286,19 +5426,19 @@ func (p *EarthParser) ShellStmt() (localctx IShellStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(369)
+		p.SetState(390)
 		p.Match(EarthParserSHELL)
 	}
-	p.SetState(372)
+	p.SetState(393)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(370)
+			p.SetState(391)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(371)
+			p.SetState(392)
 			p.StmtWords()
 		}
 
@@ -5345,22 +5485,30 @@ func NewWithDockerStmtContext(parser antlr.Parser, parent antlr.ParserRuleContex
 
 func (s *WithDockerStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *WithDockerStmtContext) WITH_DOCKER() antlr.TerminalNode {
-	return s.GetToken(EarthParserWITH_DOCKER, 0)
+func (s *WithDockerStmtContext) WithDockerClause() IWithDockerClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IWithDockerClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithDockerClauseContext)
 }
 
-func (s *WithDockerStmtContext) WS() antlr.TerminalNode {
-	return s.GetToken(EarthParserWS, 0)
+func (s *WithDockerStmtContext) END() antlr.TerminalNode {
+	return s.GetToken(EarthParserEND, 0)
 }
 
-func (s *WithDockerStmtContext) StmtWords() IStmtWordsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+func (s *WithDockerStmtContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
 
-	if t == nil {
-		return nil
-	}
+func (s *WithDockerStmtContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
 
-	return t.(IStmtWordsContext)
+func (s *WithDockerStmtContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
 }
 
 func (s *WithDockerStmtContext) GetRuleContext() antlr.RuleContext {
@@ -5386,6 +5534,7 @@ func (s *WithDockerStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 func (p *EarthParser) WithDockerStmt() (localctx IWithDockerStmtContext) {
 	localctx = NewWithDockerStmtContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 70, EarthParserRULE_withDockerStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5405,74 +5554,89 @@ func (p *EarthParser) WithDockerStmt() (localctx IWithDockerStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(374)
-		p.Match(EarthParserWITH_DOCKER)
+		p.SetState(395)
+		p.WithDockerClause()
 	}
-	p.SetState(377)
+	p.SetState(397)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
+	for ok := true; ok; ok = _la == EarthParserNL {
 		{
-			p.SetState(375)
-			p.Match(EarthParserWS)
+			p.SetState(396)
+			p.Match(EarthParserNL)
 		}
+
+		p.SetState(399)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(402)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == EarthParserWS {
 		{
-			p.SetState(376)
-			p.StmtWords()
+			p.SetState(401)
+			p.Match(EarthParserWS)
 		}
 
 	}
+	{
+		p.SetState(404)
+		p.Match(EarthParserEND)
+	}
 
 	return localctx
 }
 
-// IEndStmtContext is an interface to support dynamic dispatch.
-type IEndStmtContext interface {
+// IWithDockerClauseContext is an interface to support dynamic dispatch.
+type IWithDockerClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsEndStmtContext differentiates from other interfaces.
-	IsEndStmtContext()
+	// IsWithDockerClauseContext differentiates from other interfaces.
+	IsWithDockerClauseContext()
 }
 
-type EndStmtContext struct {
+type WithDockerClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyEndStmtContext() *EndStmtContext {
-	var p = new(EndStmtContext)
+func NewEmptyWithDockerClauseContext() *WithDockerClauseContext {
+	var p = new(WithDockerClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = EarthParserRULE_endStmt
+	p.RuleIndex = EarthParserRULE_withDockerClause
 	return p
 }
 
-func (*EndStmtContext) IsEndStmtContext() {}
+func (*WithDockerClauseContext) IsWithDockerClauseContext() {}
 
-func NewEndStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EndStmtContext {
-	var p = new(EndStmtContext)
+func NewWithDockerClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithDockerClauseContext {
+	var p = new(WithDockerClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = EarthParserRULE_endStmt
+	p.RuleIndex = EarthParserRULE_withDockerClause
 
 	return p
 }
 
-func (s *EndStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *WithDockerClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *EndStmtContext) END() antlr.TerminalNode {
-	return s.GetToken(EarthParserEND, 0)
+func (s *WithDockerClauseContext) WITH_DOCKER() antlr.TerminalNode {
+	return s.GetToken(EarthParserWITH_DOCKER, 0)
 }
 
-func (s *EndStmtContext) WS() antlr.TerminalNode {
+func (s *WithDockerClauseContext) WS() antlr.TerminalNode {
 	return s.GetToken(EarthParserWS, 0)
 }
 
-func (s *EndStmtContext) StmtWords() IStmtWordsContext {
+func (s *WithDockerClauseContext) StmtWords() IStmtWordsContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -5482,29 +5646,48 @@ func (s *EndStmtContext) StmtWords() IStmtWordsContext {
 	return t.(IStmtWordsContext)
 }
 
-func (s *EndStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *WithDockerClauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *WithDockerClauseContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
+
+func (s *WithDockerClauseContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
+
+func (s *WithDockerClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EndStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *WithDockerClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *EndStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *WithDockerClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(EarthParserListener); ok {
-		listenerT.EnterEndStmt(s)
+		listenerT.EnterWithDockerClause(s)
 	}
 }
 
-func (s *EndStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *WithDockerClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(EarthParserListener); ok {
-		listenerT.ExitEndStmt(s)
+		listenerT.ExitWithDockerClause(s)
 	}
 }
 
-func (p *EarthParser) EndStmt() (localctx IEndStmtContext) {
-	localctx = NewEndStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, EarthParserRULE_endStmt)
+func (p *EarthParser) WithDockerClause() (localctx IWithDockerClauseContext) {
+	localctx = NewWithDockerClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, EarthParserRULE_withDockerClause)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5524,112 +5707,177 @@ func (p *EarthParser) EndStmt() (localctx IEndStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(379)
-		p.Match(EarthParserEND)
+		p.SetState(406)
+		p.Match(EarthParserWITH_DOCKER)
 	}
-	p.SetState(382)
+	p.SetState(409)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) == 1 {
+	if _la == EarthParserWS {
 		{
-			p.SetState(380)
+			p.SetState(407)
 			p.Match(EarthParserWS)
 		}
 		{
-			p.SetState(381)
+			p.SetState(408)
 			p.StmtWords()
 		}
 
+	}
+	p.SetState(417)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext()) == 1 {
+		p.SetState(412)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == EarthParserNL {
+			{
+				p.SetState(411)
+				p.Match(EarthParserNL)
+			}
+
+			p.SetState(414)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(416)
+			p.Block()
+		}
+
 	}
 
 	return localctx
 }
 
-// IGenericCommandStmtContext is an interface to support dynamic dispatch.
-type IGenericCommandStmtContext interface {
+// IIfStmtContext is an interface to support dynamic dispatch.
+type IIfStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsGenericCommandStmtContext differentiates from other interfaces.
-	IsGenericCommandStmtContext()
+	// IsIfStmtContext differentiates from other interfaces.
+	IsIfStmtContext()
 }
 
-type GenericCommandStmtContext struct {
+type IfStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGenericCommandStmtContext() *GenericCommandStmtContext {
-	var p = new(GenericCommandStmtContext)
+func NewEmptyIfStmtContext() *IfStmtContext {
+	var p = new(IfStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = EarthParserRULE_genericCommandStmt
+	p.RuleIndex = EarthParserRULE_ifStmt
 	return p
 }
 
-func (*GenericCommandStmtContext) IsGenericCommandStmtContext() {}
+func (*IfStmtContext) IsIfStmtContext() {}
 
-func NewGenericCommandStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GenericCommandStmtContext {
-	var p = new(GenericCommandStmtContext)
+func NewIfStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStmtContext {
+	var p = new(IfStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = EarthParserRULE_genericCommandStmt
+	p.RuleIndex = EarthParserRULE_ifStmt
 
 	return p
 }
 
-func (s *GenericCommandStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *IfStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GenericCommandStmtContext) CommandName() ICommandNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICommandNameContext)(nil)).Elem(), 0)
+func (s *IfStmtContext) IfClause() IIfClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfClauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ICommandNameContext)
+	return t.(IIfClauseContext)
 }
 
-func (s *GenericCommandStmtContext) WS() antlr.TerminalNode {
-	return s.GetToken(EarthParserWS, 0)
+func (s *IfStmtContext) END() antlr.TerminalNode {
+	return s.GetToken(EarthParserEND, 0)
 }
 
-func (s *GenericCommandStmtContext) StmtWords() IStmtWordsContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+func (s *IfStmtContext) AllElseIfClause() []IElseIfClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IElseIfClauseContext)(nil)).Elem())
+	var tst = make([]IElseIfClauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IElseIfClauseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStmtContext) ElseIfClause(i int) IElseIfClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElseIfClauseContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStmtWordsContext)
+	return t.(IElseIfClauseContext)
 }
 
-func (s *GenericCommandStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *IfStmtContext) ElseClause() IElseClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElseClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElseClauseContext)
+}
+
+func (s *IfStmtContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
+
+func (s *IfStmtContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
+
+func (s *IfStmtContext) AllWS() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserWS)
+}
+
+func (s *IfStmtContext) WS(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, i)
+}
+
+func (s *IfStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GenericCommandStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IfStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GenericCommandStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IfStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(EarthParserListener); ok {
-		listenerT.EnterGenericCommandStmt(s)
+		listenerT.EnterIfStmt(s)
 	}
 }
 
-func (s *GenericCommandStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IfStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(EarthParserListener); ok {
-		listenerT.ExitGenericCommandStmt(s)
+		listenerT.ExitIfStmt(s)
 	}
 }
 
-func (p *EarthParser) GenericCommandStmt() (localctx IGenericCommandStmtContext) {
-	localctx = NewGenericCommandStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, EarthParserRULE_genericCommandStmt)
+func (p *EarthParser) IfStmt() (localctx IIfStmtContext) {
+	localctx = NewIfStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, EarthParserRULE_ifStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -5647,21 +5895,1340 @@ func (p *EarthParser) GenericCommandStmt() (localctx IGenericCommandStmtContext)
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(384)
-		p.CommandName()
+		p.SetState(419)
+		p.IfClause()
 	}
-	p.SetState(387)
+	p.SetState(431)
 	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(385)
-			p.Match(EarthParserWS)
-		}
-		{
-			p.SetState(386)
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			p.SetState(421)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for ok := true; ok; ok = _la == EarthParserNL {
+				{
+					p.SetState(420)
+					p.Match(EarthParserNL)
+				}
+
+				p.SetState(423)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+			p.SetState(426)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == EarthParserWS {
+				{
+					p.SetState(425)
+					p.Match(EarthParserWS)
+				}
+
+			}
+			{
+				p.SetState(428)
+				p.ElseIfClause()
+			}
+
+		}
+		p.SetState(433)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext())
+	}
+	p.SetState(443)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) == 1 {
+		p.SetState(435)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == EarthParserNL {
+			{
+				p.SetState(434)
+				p.Match(EarthParserNL)
+			}
+
+			p.SetState(437)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(440)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == EarthParserWS {
+			{
+				p.SetState(439)
+				p.Match(EarthParserWS)
+			}
+
+		}
+		{
+			p.SetState(442)
+			p.ElseClause()
+		}
+
+	}
+	p.SetState(446)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == EarthParserNL {
+		{
+			p.SetState(445)
+			p.Match(EarthParserNL)
+		}
+
+		p.SetState(448)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(451)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == EarthParserWS {
+		{
+			p.SetState(450)
+			p.Match(EarthParserWS)
+		}
+
+	}
+	{
+		p.SetState(453)
+		p.Match(EarthParserEND)
+	}
+
+	return localctx
+}
+
+// IIfClauseContext is an interface to support dynamic dispatch.
+type IIfClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsIfClauseContext differentiates from other interfaces.
+	IsIfClauseContext()
+}
+
+type IfClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyIfClauseContext() *IfClauseContext {
+	var p = new(IfClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_ifClause
+	return p
+}
+
+func (*IfClauseContext) IsIfClauseContext() {}
+
+func NewIfClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfClauseContext {
+	var p = new(IfClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_ifClause
+
+	return p
+}
+
+func (s *IfClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *IfClauseContext) IF() antlr.TerminalNode {
+	return s.GetToken(EarthParserIF, 0)
+}
+
+func (s *IfClauseContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
+}
+
+func (s *IfClauseContext) StmtWords() IStmtWordsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStmtWordsContext)
+}
+
+func (s *IfClauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *IfClauseContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
+
+func (s *IfClauseContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
+
+func (s *IfClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IfClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *IfClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterIfClause(s)
+	}
+}
+
+func (s *IfClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitIfClause(s)
+	}
+}
+
+func (p *EarthParser) IfClause() (localctx IIfClauseContext) {
+	localctx = NewIfClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, EarthParserRULE_ifClause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(455)
+		p.Match(EarthParserIF)
+	}
+	p.SetState(458)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == EarthParserWS {
+		{
+			p.SetState(456)
+			p.Match(EarthParserWS)
+		}
+		{
+			p.SetState(457)
+			p.StmtWords()
+		}
+
+	}
+	p.SetState(466)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext()) == 1 {
+		p.SetState(461)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == EarthParserNL {
+			{
+				p.SetState(460)
+				p.Match(EarthParserNL)
+			}
+
+			p.SetState(463)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(465)
+			p.Block()
+		}
+
+	}
+
+	return localctx
+}
+
+// IElseIfClauseContext is an interface to support dynamic dispatch.
+type IElseIfClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsElseIfClauseContext differentiates from other interfaces.
+	IsElseIfClauseContext()
+}
+
+type ElseIfClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyElseIfClauseContext() *ElseIfClauseContext {
+	var p = new(ElseIfClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_elseIfClause
+	return p
+}
+
+func (*ElseIfClauseContext) IsElseIfClauseContext() {}
+
+func NewElseIfClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseIfClauseContext {
+	var p = new(ElseIfClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_elseIfClause
+
+	return p
+}
+
+func (s *ElseIfClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ElseIfClauseContext) ELSE_IF() antlr.TerminalNode {
+	return s.GetToken(EarthParserELSE_IF, 0)
+}
+
+func (s *ElseIfClauseContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
+}
+
+func (s *ElseIfClauseContext) StmtWords() IStmtWordsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStmtWordsContext)
+}
+
+func (s *ElseIfClauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ElseIfClauseContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
+
+func (s *ElseIfClauseContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
+
+func (s *ElseIfClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ElseIfClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ElseIfClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterElseIfClause(s)
+	}
+}
+
+func (s *ElseIfClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitElseIfClause(s)
+	}
+}
+
+func (p *EarthParser) ElseIfClause() (localctx IElseIfClauseContext) {
+	localctx = NewElseIfClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, EarthParserRULE_elseIfClause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(468)
+		p.Match(EarthParserELSE_IF)
+	}
+	p.SetState(471)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == EarthParserWS {
+		{
+			p.SetState(469)
+			p.Match(EarthParserWS)
+		}
+		{
+			p.SetState(470)
+			p.StmtWords()
+		}
+
+	}
+	p.SetState(479)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) == 1 {
+		p.SetState(474)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == EarthParserNL {
+			{
+				p.SetState(473)
+				p.Match(EarthParserNL)
+			}
+
+			p.SetState(476)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(478)
+			p.Block()
+		}
+
+	}
+
+	return localctx
+}
+
+// IElseClauseContext is an interface to support dynamic dispatch.
+type IElseClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsElseClauseContext differentiates from other interfaces.
+	IsElseClauseContext()
+}
+
+type ElseClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyElseClauseContext() *ElseClauseContext {
+	var p = new(ElseClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_elseClause
+	return p
+}
+
+func (*ElseClauseContext) IsElseClauseContext() {}
+
+func NewElseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseClauseContext {
+	var p = new(ElseClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_elseClause
+
+	return p
+}
+
+func (s *ElseClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ElseClauseContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(EarthParserELSE, 0)
+}
+
+func (s *ElseClauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ElseClauseContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
+
+func (s *ElseClauseContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
+
+func (s *ElseClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ElseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ElseClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterElseClause(s)
+	}
+}
+
+func (s *ElseClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitElseClause(s)
+	}
+}
+
+func (p *EarthParser) ElseClause() (localctx IElseClauseContext) {
+	localctx = NewElseClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, EarthParserRULE_elseClause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(481)
+		p.Match(EarthParserELSE)
+	}
+	p.SetState(488)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
+		p.SetState(483)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == EarthParserNL {
+			{
+				p.SetState(482)
+				p.Match(EarthParserNL)
+			}
+
+			p.SetState(485)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(487)
+			p.Block()
+		}
+
+	}
+
+	return localctx
+}
+
+// IForStmtContext is an interface to support dynamic dispatch.
+type IForStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsForStmtContext differentiates from other interfaces.
+	IsForStmtContext()
+}
+
+type ForStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyForStmtContext() *ForStmtContext {
+	var p = new(ForStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_forStmt
+	return p
+}
+
+func (*ForStmtContext) IsForStmtContext() {}
+
+func NewForStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForStmtContext {
+	var p = new(ForStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_forStmt
+
+	return p
+}
+
+func (s *ForStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ForStmtContext) ForClause() IForClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForClauseContext)
+}
+
+func (s *ForStmtContext) END() antlr.TerminalNode {
+	return s.GetToken(EarthParserEND, 0)
+}
+
+func (s *ForStmtContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
+
+func (s *ForStmtContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
+
+func (s *ForStmtContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
+}
+
+func (s *ForStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ForStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ForStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterForStmt(s)
+	}
+}
+
+func (s *ForStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitForStmt(s)
+	}
+}
+
+func (p *EarthParser) ForStmt() (localctx IForStmtContext) {
+	localctx = NewForStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, EarthParserRULE_forStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(490)
+		p.ForClause()
+	}
+	p.SetState(492)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = _la == EarthParserNL {
+		{
+			p.SetState(491)
+			p.Match(EarthParserNL)
+		}
+
+		p.SetState(494)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(497)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == EarthParserWS {
+		{
+			p.SetState(496)
+			p.Match(EarthParserWS)
+		}
+
+	}
+	{
+		p.SetState(499)
+		p.Match(EarthParserEND)
+	}
+
+	return localctx
+}
+
+// IForClauseContext is an interface to support dynamic dispatch.
+type IForClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsForClauseContext differentiates from other interfaces.
+	IsForClauseContext()
+}
+
+type ForClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyForClauseContext() *ForClauseContext {
+	var p = new(ForClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_forClause
+	return p
+}
+
+func (*ForClauseContext) IsForClauseContext() {}
+
+func NewForClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForClauseContext {
+	var p = new(ForClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_forClause
+
+	return p
+}
+
+func (s *ForClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ForClauseContext) FOR() antlr.TerminalNode {
+	return s.GetToken(EarthParserFOR, 0)
+}
+
+func (s *ForClauseContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
+}
+
+func (s *ForClauseContext) StmtWords() IStmtWordsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStmtWordsContext)
+}
+
+func (s *ForClauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ForClauseContext) AllNL() []antlr.TerminalNode {
+	return s.GetTokens(EarthParserNL)
+}
+
+func (s *ForClauseContext) NL(i int) antlr.TerminalNode {
+	return s.GetToken(EarthParserNL, i)
+}
+
+func (s *ForClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ForClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ForClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterForClause(s)
+	}
+}
+
+func (s *ForClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitForClause(s)
+	}
+}
+
+func (p *EarthParser) ForClause() (localctx IForClauseContext) {
+	localctx = NewForClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, EarthParserRULE_forClause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(501)
+		p.Match(EarthParserFOR)
+	}
+	p.SetState(504)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == EarthParserWS {
+		{
+			p.SetState(502)
+			p.Match(EarthParserWS)
+		}
+		{
+			p.SetState(503)
+			p.StmtWords()
+		}
+
+	}
+	p.SetState(512)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) == 1 {
+		p.SetState(507)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == EarthParserNL {
+			{
+				p.SetState(506)
+				p.Match(EarthParserNL)
+			}
+
+			p.SetState(509)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(511)
+			p.Block()
+		}
+
+	}
+
+	return localctx
+}
+
+// IBlockContext is an interface to support dynamic dispatch.
+type IBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBlockContext differentiates from other interfaces.
+	IsBlockContext()
+}
+
+type BlockContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBlockContext() *BlockContext {
+	var p = new(BlockContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_block
+	return p
+}
+
+func (*BlockContext) IsBlockContext() {}
+
+func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
+	var p = new(BlockContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_block
+
+	return p
+}
+
+func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BlockContext) Stmts() IStmtsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStmtsContext)
+}
+
+func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BlockContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterBlock(s)
+	}
+}
+
+func (s *BlockContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitBlock(s)
+	}
+}
+
+func (p *EarthParser) Block() (localctx IBlockContext) {
+	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, EarthParserRULE_block)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(514)
+		p.Stmts()
+	}
+
+	return localctx
+}
+
+// ICommandStmtContext is an interface to support dynamic dispatch.
+type ICommandStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCommandStmtContext differentiates from other interfaces.
+	IsCommandStmtContext()
+}
+
+type CommandStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCommandStmtContext() *CommandStmtContext {
+	var p = new(CommandStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_commandStmt
+	return p
+}
+
+func (*CommandStmtContext) IsCommandStmtContext() {}
+
+func NewCommandStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CommandStmtContext {
+	var p = new(CommandStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_commandStmt
+
+	return p
+}
+
+func (s *CommandStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CommandStmtContext) COMMAND() antlr.TerminalNode {
+	return s.GetToken(EarthParserCOMMAND, 0)
+}
+
+func (s *CommandStmtContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
+}
+
+func (s *CommandStmtContext) StmtWords() IStmtWordsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStmtWordsContext)
+}
+
+func (s *CommandStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CommandStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CommandStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterCommandStmt(s)
+	}
+}
+
+func (s *CommandStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitCommandStmt(s)
+	}
+}
+
+func (p *EarthParser) CommandStmt() (localctx ICommandStmtContext) {
+	localctx = NewCommandStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, EarthParserRULE_commandStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(516)
+		p.Match(EarthParserCOMMAND)
+	}
+	p.SetState(519)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(517)
+			p.Match(EarthParserWS)
+		}
+		{
+			p.SetState(518)
+			p.StmtWords()
+		}
+
+	}
+
+	return localctx
+}
+
+// IDoStmtContext is an interface to support dynamic dispatch.
+type IDoStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDoStmtContext differentiates from other interfaces.
+	IsDoStmtContext()
+}
+
+type DoStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDoStmtContext() *DoStmtContext {
+	var p = new(DoStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_doStmt
+	return p
+}
+
+func (*DoStmtContext) IsDoStmtContext() {}
+
+func NewDoStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DoStmtContext {
+	var p = new(DoStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_doStmt
+
+	return p
+}
+
+func (s *DoStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DoStmtContext) DO() antlr.TerminalNode {
+	return s.GetToken(EarthParserDO, 0)
+}
+
+func (s *DoStmtContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
+}
+
+func (s *DoStmtContext) StmtWords() IStmtWordsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStmtWordsContext)
+}
+
+func (s *DoStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DoStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DoStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterDoStmt(s)
+	}
+}
+
+func (s *DoStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitDoStmt(s)
+	}
+}
+
+func (p *EarthParser) DoStmt() (localctx IDoStmtContext) {
+	localctx = NewDoStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, EarthParserRULE_doStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(521)
+		p.Match(EarthParserDO)
+	}
+	p.SetState(524)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(522)
+			p.Match(EarthParserWS)
+		}
+		{
+			p.SetState(523)
+			p.StmtWords()
+		}
+
+	}
+
+	return localctx
+}
+
+// IGenericCommandStmtContext is an interface to support dynamic dispatch.
+type IGenericCommandStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGenericCommandStmtContext differentiates from other interfaces.
+	IsGenericCommandStmtContext()
+}
+
+type GenericCommandStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGenericCommandStmtContext() *GenericCommandStmtContext {
+	var p = new(GenericCommandStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = EarthParserRULE_genericCommandStmt
+	return p
+}
+
+func (*GenericCommandStmtContext) IsGenericCommandStmtContext() {}
+
+func NewGenericCommandStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GenericCommandStmtContext {
+	var p = new(GenericCommandStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = EarthParserRULE_genericCommandStmt
+
+	return p
+}
+
+func (s *GenericCommandStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GenericCommandStmtContext) CommandName() ICommandNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICommandNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICommandNameContext)
+}
+
+func (s *GenericCommandStmtContext) WS() antlr.TerminalNode {
+	return s.GetToken(EarthParserWS, 0)
+}
+
+func (s *GenericCommandStmtContext) StmtWords() IStmtWordsContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStmtWordsContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStmtWordsContext)
+}
+
+func (s *GenericCommandStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GenericCommandStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GenericCommandStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.EnterGenericCommandStmt(s)
+	}
+}
+
+func (s *GenericCommandStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(EarthParserListener); ok {
+		listenerT.ExitGenericCommandStmt(s)
+	}
+}
+
+func (p *EarthParser) GenericCommandStmt() (localctx IGenericCommandStmtContext) {
+	localctx = NewGenericCommandStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, EarthParserRULE_genericCommandStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(526)
+		p.CommandName()
+	}
+	p.SetState(529)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(527)
+			p.Match(EarthParserWS)
+		}
+		{
+			p.SetState(528)
 			p.StmtWords()
 		}
 
@@ -5734,7 +7301,7 @@ func (s *CommandNameContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *EarthParser) CommandName() (localctx ICommandNameContext) {
 	localctx = NewCommandNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, EarthParserRULE_commandName)
+	p.EnterRule(localctx, 94, EarthParserRULE_commandName)
 
 	defer func() {
 		p.ExitRule()
@@ -5754,7 +7321,7 @@ func (p *EarthParser) CommandName() (localctx ICommandNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(389)
+		p.SetState(531)
 		p.Match(EarthParserCommand)
 	}
 
@@ -5831,7 +7398,7 @@ func (s *StmtWordsMaybeJSONContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *EarthParser) StmtWordsMaybeJSON() (localctx IStmtWordsMaybeJSONContext) {
 	localctx = NewStmtWordsMaybeJSONContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, EarthParserRULE_stmtWordsMaybeJSON)
+	p.EnterRule(localctx, 96, EarthParserRULE_stmtWordsMaybeJSON)
 
 	defer func() {
 		p.ExitRule()
@@ -5851,7 +7418,7 @@ func (p *EarthParser) StmtWordsMaybeJSON() (localctx IStmtWordsMaybeJSONContext)
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(391)
+		p.SetState(533)
 		p.StmtWords()
 	}
 
@@ -5949,7 +7516,7 @@ func (s *StmtWordsContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *EarthParser) StmtWords() (localctx IStmtWordsContext) {
 	localctx = NewStmtWordsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, EarthParserRULE_stmtWords)
+	p.EnterRule(localctx, 98, EarthParserRULE_stmtWords)
 	var _la int
 
 	defer func() {
@@ -5972,35 +7539,35 @@ func (p *EarthParser) StmtWords() (localctx IStmtWordsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(393)
+		p.SetState(535)
 		p.StmtWord()
 	}
-	p.SetState(400)
+	p.SetState(542)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(395)
+			p.SetState(537)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
 			if _la == EarthParserWS {
 				{
-					p.SetState(394)
+					p.SetState(536)
 					p.Match(EarthParserWS)
 				}
 
 			}
 			{
-				p.SetState(397)
+				p.SetState(539)
 				p.StmtWord()
 			}
 
 		}
-		p.SetState(402)
+		p.SetState(544)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -6070,7 +7637,7 @@ func (s *StmtWordContext) ExitRule(listener antlr.ParseTreeListener) {
 
 func (p *EarthParser) StmtWord() (localctx IStmtWordContext) {
 	localctx = NewStmtWordContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, EarthParserRULE_stmtWord)
+	p.EnterRule(localctx, 100, EarthParserRULE_stmtWord)
 
 	defer func() {
 		p.ExitRule()
@@ -6090,7 +7657,7 @@ func (p *EarthParser) StmtWord() (localctx IStmtWordContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(403)
+		p.SetState(545)
 		p.Match(EarthParserAtom)
 	}
 
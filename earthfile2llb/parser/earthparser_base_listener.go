@@ -237,11 +237,65 @@ func (s *BaseEarthParserListener) EnterWithDockerStmt(ctx *WithDockerStmtContext
 // ExitWithDockerStmt is called when production withDockerStmt is exited.
 func (s *BaseEarthParserListener) ExitWithDockerStmt(ctx *WithDockerStmtContext) {}
 
-// EnterEndStmt is called when production endStmt is entered.
-func (s *BaseEarthParserListener) EnterEndStmt(ctx *EndStmtContext) {}
+// EnterWithDockerClause is called when production withDockerClause is entered.
+func (s *BaseEarthParserListener) EnterWithDockerClause(ctx *WithDockerClauseContext) {}
 
-// ExitEndStmt is called when production endStmt is exited.
-func (s *BaseEarthParserListener) ExitEndStmt(ctx *EndStmtContext) {}
+// ExitWithDockerClause is called when production withDockerClause is exited.
+func (s *BaseEarthParserListener) ExitWithDockerClause(ctx *WithDockerClauseContext) {}
+
+// EnterIfStmt is called when production ifStmt is entered.
+func (s *BaseEarthParserListener) EnterIfStmt(ctx *IfStmtContext) {}
+
+// ExitIfStmt is called when production ifStmt is exited.
+func (s *BaseEarthParserListener) ExitIfStmt(ctx *IfStmtContext) {}
+
+// EnterIfClause is called when production ifClause is entered.
+func (s *BaseEarthParserListener) EnterIfClause(ctx *IfClauseContext) {}
+
+// ExitIfClause is called when production ifClause is exited.
+func (s *BaseEarthParserListener) ExitIfClause(ctx *IfClauseContext) {}
+
+// EnterElseIfClause is called when production elseIfClause is entered.
+func (s *BaseEarthParserListener) EnterElseIfClause(ctx *ElseIfClauseContext) {}
+
+// ExitElseIfClause is called when production elseIfClause is exited.
+func (s *BaseEarthParserListener) ExitElseIfClause(ctx *ElseIfClauseContext) {}
+
+// EnterElseClause is called when production elseClause is entered.
+func (s *BaseEarthParserListener) EnterElseClause(ctx *ElseClauseContext) {}
+
+// ExitElseClause is called when production elseClause is exited.
+func (s *BaseEarthParserListener) ExitElseClause(ctx *ElseClauseContext) {}
+
+// EnterForStmt is called when production forStmt is entered.
+func (s *BaseEarthParserListener) EnterForStmt(ctx *ForStmtContext) {}
+
+// ExitForStmt is called when production forStmt is exited.
+func (s *BaseEarthParserListener) ExitForStmt(ctx *ForStmtContext) {}
+
+// EnterForClause is called when production forClause is entered.
+func (s *BaseEarthParserListener) EnterForClause(ctx *ForClauseContext) {}
+
+// ExitForClause is called when production forClause is exited.
+func (s *BaseEarthParserListener) ExitForClause(ctx *ForClauseContext) {}
+
+// EnterBlock is called when production block is entered.
+func (s *BaseEarthParserListener) EnterBlock(ctx *BlockContext) {}
+
+// ExitBlock is called when production block is exited.
+func (s *BaseEarthParserListener) ExitBlock(ctx *BlockContext) {}
+
+// EnterCommandStmt is called when production commandStmt is entered.
+func (s *BaseEarthParserListener) EnterCommandStmt(ctx *CommandStmtContext) {}
+
+// ExitCommandStmt is called when production commandStmt is exited.
+func (s *BaseEarthParserListener) ExitCommandStmt(ctx *CommandStmtContext) {}
+
+// EnterDoStmt is called when production doStmt is entered.
+func (s *BaseEarthParserListener) EnterDoStmt(ctx *DoStmtContext) {}
+
+// ExitDoStmt is called when production doStmt is exited.
+func (s *BaseEarthParserListener) ExitDoStmt(ctx *DoStmtContext) {}
 
 // EnterGenericCommandStmt is called when production genericCommandStmt is entered.
 func (s *BaseEarthParserListener) EnterGenericCommandStmt(ctx *GenericCommandStmtContext) {}
@@ -116,8 +116,35 @@ type EarthParserListener interface {
 	// EnterWithDockerStmt is called when entering the withDockerStmt production.
 	EnterWithDockerStmt(c *WithDockerStmtContext)
 
-	// EnterEndStmt is called when entering the endStmt production.
-	EnterEndStmt(c *EndStmtContext)
+	// EnterWithDockerClause is called when entering the withDockerClause production.
+	EnterWithDockerClause(c *WithDockerClauseContext)
+
+	// EnterIfStmt is called when entering the ifStmt production.
+	EnterIfStmt(c *IfStmtContext)
+
+	// EnterIfClause is called when entering the ifClause production.
+	EnterIfClause(c *IfClauseContext)
+
+	// EnterElseIfClause is called when entering the elseIfClause production.
+	EnterElseIfClause(c *ElseIfClauseContext)
+
+	// EnterElseClause is called when entering the elseClause production.
+	EnterElseClause(c *ElseClauseContext)
+
+	// EnterForStmt is called when entering the forStmt production.
+	EnterForStmt(c *ForStmtContext)
+
+	// EnterForClause is called when entering the forClause production.
+	EnterForClause(c *ForClauseContext)
+
+	// EnterBlock is called when entering the block production.
+	EnterBlock(c *BlockContext)
+
+	// EnterCommandStmt is called when entering the commandStmt production.
+	EnterCommandStmt(c *CommandStmtContext)
+
+	// EnterDoStmt is called when entering the doStmt production.
+	EnterDoStmt(c *DoStmtContext)
 
 	// EnterGenericCommandStmt is called when entering the genericCommandStmt production.
 	EnterGenericCommandStmt(c *GenericCommandStmtContext)
@@ -242,8 +269,35 @@ type EarthParserListener interface {
 	// ExitWithDockerStmt is called when exiting the withDockerStmt production.
 	ExitWithDockerStmt(c *WithDockerStmtContext)
 
-	// ExitEndStmt is called when exiting the endStmt production.
-	ExitEndStmt(c *EndStmtContext)
+	// ExitWithDockerClause is called when exiting the withDockerClause production.
+	ExitWithDockerClause(c *WithDockerClauseContext)
+
+	// ExitIfStmt is called when exiting the ifStmt production.
+	ExitIfStmt(c *IfStmtContext)
+
+	// ExitIfClause is called when exiting the ifClause production.
+	ExitIfClause(c *IfClauseContext)
+
+	// ExitElseIfClause is called when exiting the elseIfClause production.
+	ExitElseIfClause(c *ElseIfClauseContext)
+
+	// ExitElseClause is called when exiting the elseClause production.
+	ExitElseClause(c *ElseClauseContext)
+
+	// ExitForStmt is called when exiting the forStmt production.
+	ExitForStmt(c *ForStmtContext)
+
+	// ExitForClause is called when exiting the forClause production.
+	ExitForClause(c *ForClauseContext)
+
+	// ExitBlock is called when exiting the block production.
+	ExitBlock(c *BlockContext)
+
+	// ExitCommandStmt is called when exiting the commandStmt production.
+	ExitCommandStmt(c *CommandStmtContext)
+
+	// ExitDoStmt is called when exiting the doStmt production.
+	ExitDoStmt(c *DoStmtContext)
 
 	// ExitGenericCommandStmt is called when exiting the genericCommandStmt production.
 	ExitGenericCommandStmt(c *GenericCommandStmtContext)
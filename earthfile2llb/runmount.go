@@ -1,37 +1,80 @@
 package earthfile2llb
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/earthly/earthly/domain"
+	"github.com/earthly/earthly/features"
 	"github.com/earthly/earthly/llbutil"
 	"github.com/earthly/earthly/states/dedup"
 	"github.com/moby/buildkit/client/llb"
 	"github.com/pkg/errors"
 )
 
-func parseMounts(mounts []string, target domain.Target, ti dedup.TargetInput, cacheContext llb.State) ([]llb.RunOption, error) {
+// runMount is the parsed form of a RUN --mount arg.
+type runMount struct {
+	Type     string
+	Source   string
+	Target   string
+	ID       string
+	From     string
+	ReadOnly bool
+	Sharing  llb.CacheMountSharingMode
+	// UID, GID and Mode are nil if not specified.
+	UID  *int
+	GID  *int
+	Mode *int
+}
+
+func (rm *runMount) hasOwnership() bool {
+	return rm.UID != nil || rm.GID != nil || rm.Mode != nil
+}
+
+func (rm *runMount) ownership(defaultMode int) (int, int, int) {
+	uid, gid, mode := 0, 0, defaultMode
+	if rm.UID != nil {
+		uid = *rm.UID
+	}
+	if rm.GID != nil {
+		gid = *rm.GID
+	}
+	if rm.Mode != nil {
+		mode = *rm.Mode
+	}
+	return uid, gid, mode
+}
+
+func (c *Converter) parseMounts(ctx context.Context, mounts []string) ([]llb.RunOption, error) {
 	var runOpts []llb.RunOption
 	for _, mount := range mounts {
-		mountRunOpts, err := parseMount(mount, target, ti, cacheContext)
+		rm, err := parseRunMount(mount)
 		if err != nil {
 			return nil, errors.Wrap(err, "parse mount")
 		}
+		if rm.Type == "bind" || rm.From != "" || rm.hasOwnership() {
+			err = c.opt.Features.Check(features.MountOptions)
+			if err != nil {
+				return nil, errors.Wrapf(err, "mount %s", mount)
+			}
+		}
+		mountRunOpts, err := c.mountRunOpts(ctx, rm)
+		if err != nil {
+			return nil, errors.Wrapf(err, "mount %s", mount)
+		}
 		runOpts = append(runOpts, mountRunOpts...)
 	}
 	return runOpts, nil
 }
 
-func parseMount(mount string, target domain.Target, ti dedup.TargetInput, cacheContext llb.State) ([]llb.RunOption, error) {
-	var state llb.State
-	var mountSource string
-	var mountTarget string
-	var mountID string
-	var mountType string
-	var mountOpts []llb.MountOption
-	sharingMode := llb.CacheMountShared
+func parseRunMount(mount string) (*runMount, error) {
+	rm := &runMount{
+		Sharing: llb.CacheMountShared,
+	}
 	kvPairs := strings.Split(mount, ",")
 	for _, kvPair := range kvPairs {
 		kvSplit := strings.SplitN(kvPair, "=", 2)
@@ -43,138 +86,207 @@ func parseMount(mount string, target domain.Target, ti dedup.TargetInput, cacheC
 			if len(kvSplit) != 2 {
 				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 			}
-			mountID = kvSplit[1]
+			rm.ID = kvSplit[1]
 		case "type":
 			if len(kvSplit) != 2 {
 				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 			}
-			mountType = kvSplit[1]
+			rm.Type = kvSplit[1]
 		case "source":
 			if len(kvSplit) != 2 {
 				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 			}
-			mountSource = kvSplit[1]
+			rm.Source = kvSplit[1]
 		case "target":
 			if len(kvSplit) != 2 {
 				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 			}
-			mountTarget = kvSplit[1]
+			rm.Target = kvSplit[1]
 		case "ro", "readonly":
 			if len(kvSplit) != 1 {
 				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 			}
-			mountOpts = append(mountOpts, llb.Readonly)
+			rm.ReadOnly = true
 		case "uid":
-			return nil, fmt.Errorf("not yet supported %s", kvPair)
-			// if len(kvSplit) != 2 {
-			// 	return nil, fmt.Errorf("invalid mount arg %s", kvPair)
-			// }
-			// var err error
-			// uid, err = strconv.ParseInt(kvSplit[1], 10, 64)
-			// if err != nil {
-			// 	return nil, fmt.Errorf("invalid mount arg %s", kvPair)
-			// }
+			if len(kvSplit) != 2 {
+				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
+			}
+			uid, err := strconv.ParseUint(kvSplit[1], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
+			}
+			rm.UID = intPtr(int(uid))
 		case "gid":
-			return nil, fmt.Errorf("not yet supported %s", kvPair)
-			// if len(kvSplit) != 2 {
-			// 	return nil, fmt.Errorf("invalid mount arg %s", kvPair)
-			// }
-			// var err error
-			// gid, err = strconv.ParseInt(kvSplit[1], 10, 64)
-			// if err != nil {
-			// 	return nil, fmt.Errorf("invalid mount arg %s", kvPair)
-			// }
+			if len(kvSplit) != 2 {
+				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
+			}
+			gid, err := strconv.ParseUint(kvSplit[1], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
+			}
+			rm.GID = intPtr(int(gid))
 		case "mode":
-			return nil, fmt.Errorf("not yet supported %s", kvPair)
-			// if len(kvSplit) != 2 {
-			// 	return nil, fmt.Errorf("invalid mount arg %s", kvPair)
-			// }
-			// var err error
-			// var mode64 int64
-			// mode64, err = strconv.ParseInt(kvSplit[1], 8, 64)
-			// if err != nil {
-			// 	return nil, fmt.Errorf("invalid mount arg %s", kvPair)
-			// }
-			// mode = int(mode64)
+			if len(kvSplit) != 2 {
+				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
+			}
+			mode, err := strconv.ParseUint(kvSplit[1], 8, 32)
+			if err != nil || mode > 07777 {
+				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
+			}
+			rm.Mode = intPtr(int(mode))
 		case "sharing":
 			if len(kvSplit) != 2 {
 				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 			}
 			switch kvSplit[1] {
 			case "shared":
-				sharingMode = llb.CacheMountShared
+				rm.Sharing = llb.CacheMountShared
 			case "private":
-				sharingMode = llb.CacheMountPrivate
+				rm.Sharing = llb.CacheMountPrivate
 			case "locked":
-				sharingMode = llb.CacheMountLocked
+				rm.Sharing = llb.CacheMountLocked
 			default:
 				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 			}
 		case "from":
-			return nil, fmt.Errorf("not yet supported %s", kvPair)
+			if len(kvSplit) != 2 {
+				return nil, fmt.Errorf("invalid mount arg %s", kvPair)
+			}
+			_, err := domain.ParseArtifact(kvSplit[1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid mount arg %s: from must be an artifact reference", kvPair)
+			}
+			rm.From = kvSplit[1]
 		default:
 			return nil, fmt.Errorf("invalid mount arg %s", kvPair)
 		}
 	}
-	if mountType == "" {
+	if rm.Type == "" {
 		return nil, fmt.Errorf("mount type not specified")
 	}
-	if mountID == "" {
-		mountID = path.Clean(mountTarget)
+	if rm.ID == "" {
+		rm.ID = path.Clean(rm.Target)
+	}
+	switch rm.Type {
+	case "bind", "bind-experimental", "cache", "tmpfs", "secret", "ssh-experimental":
+	default:
+		return nil, fmt.Errorf("invalid mount type %s", rm.Type)
+	}
+	if rm.Type != "ssh-experimental" && rm.Target == "" {
+		return nil, fmt.Errorf("mount target not specified")
+	}
+	if rm.From != "" && rm.Type != "bind" && rm.Type != "cache" {
+		return nil, fmt.Errorf("from is not supported for mount type %s", rm.Type)
+	}
+	if rm.hasOwnership() {
+		switch rm.Type {
+		case "cache", "secret", "ssh-experimental":
+		default:
+			return nil, fmt.Errorf("uid, gid and mode are not supported for mount type %s", rm.Type)
+		}
+		if rm.Type == "cache" && rm.From != "" {
+			return nil, errors.New("uid, gid and mode cannot be combined with from for cache mounts")
+		}
+		if rm.Type == "ssh-experimental" && rm.Target == "" {
+			return nil, errors.New("uid, gid and mode require a target for ssh mounts")
+		}
 	}
+	return rm, nil
+}
 
-	switch mountType {
+func (c *Converter) mountRunOpts(ctx context.Context, rm *runMount) ([]llb.RunOption, error) {
+	var mountOpts []llb.MountOption
+	if rm.ReadOnly {
+		mountOpts = append(mountOpts, llb.Readonly)
+	}
+	switch rm.Type {
 	case "bind-experimental":
-		if mountSource == "" {
+		if rm.Source == "" {
 			return nil, fmt.Errorf("mount source not specified")
 		}
-		if mountTarget == "" {
-			return nil, fmt.Errorf("mount target not specified")
+		mountOpts = append(mountOpts, llb.HostBind(), llb.SourcePath(rm.Source))
+		return []llb.RunOption{llb.AddMount(rm.Target, llb.Scratch(), mountOpts...)}, nil
+	case "bind":
+		if rm.From == "" {
+			// Bind the build context.
+			if rm.Source != "" {
+				mountOpts = append(mountOpts, llb.SourcePath(rm.Source))
+			}
+			return []llb.RunOption{llb.AddMount(rm.Target, c.buildContext, mountOpts...)}, nil
 		}
-		mountOpts = append(mountOpts, llb.HostBind(), llb.SourcePath(mountSource))
-		return []llb.RunOption{llb.AddMount(mountTarget, llb.Scratch(), mountOpts...)}, nil
-	case "cache":
-		if mountTarget == "" {
-			return nil, fmt.Errorf("mount target not specified")
+		state, sourcePath, err := c.mountFromState(ctx, rm)
+		if err != nil {
+			return nil, err
 		}
-		key, err := cacheKeyTargetInput(ti)
+		// Artifacts of other targets are always mounted read-only.
+		mountOpts = append(mountOpts, llb.Readonly, llb.SourcePath(sourcePath))
+		return []llb.RunOption{llb.AddMount(rm.Target, state, mountOpts...)}, nil
+	case "cache":
+		key, err := cacheKeyTargetInput(c.mts.Final.TargetInput)
 		if err != nil {
 			return nil, err
 		}
-		cachePath := path.Join("/run/cache", key, mountID)
-		mountOpts = append(mountOpts, llb.AsPersistentCacheDir(cachePath, sharingMode))
-		state = cacheContext
-		return []llb.RunOption{llb.AddMount(mountTarget, state, mountOpts...)}, nil
-	case "tmpfs":
-		if mountTarget == "" {
-			return nil, fmt.Errorf("mount target not specified")
+		cachePath := path.Join("/run/cache", key, rm.ID)
+		mountOpts = append(mountOpts, llb.AsPersistentCacheDir(cachePath, rm.Sharing))
+		state := c.cacheContext
+		switch {
+		case rm.From != "":
+			// The artifact is used as the initial contents of the cache.
+			var sourcePath string
+			state, sourcePath, err = c.mountFromState(ctx, rm)
+			if err != nil {
+				return nil, err
+			}
+			mountOpts = append(mountOpts, llb.SourcePath(sourcePath))
+		case rm.hasOwnership():
+			uid, gid, mode := rm.ownership(0755)
+			state = llbutil.ScratchWithPlatform().File(
+				llb.Mkdir("/cache", os.FileMode(mode), llb.WithUIDGID(uid, gid)),
+				llb.WithCustomName("[internal] settings cache mount permissions"))
+			mountOpts = append(mountOpts, llb.SourcePath("/cache"))
 		}
-		state = llbutil.ScratchWithPlatform()
+		return []llb.RunOption{llb.AddMount(rm.Target, state, mountOpts...)}, nil
+	case "tmpfs":
 		mountOpts = append(mountOpts, llb.Tmpfs())
-		return []llb.RunOption{llb.AddMount(mountTarget, state, mountOpts...)}, nil
+		return []llb.RunOption{llb.AddMount(rm.Target, llbutil.ScratchWithPlatform(), mountOpts...)}, nil
 	case "ssh-experimental":
-		sshOpts := []llb.SSHOption{llb.SSHID(mountID)}
-		if mountTarget != "" {
-			sshOpts = append(sshOpts, llb.SSHSocketTarget(mountTarget))
+		sshOpts := []llb.SSHOption{llb.SSHID(rm.ID)}
+		if rm.hasOwnership() {
+			uid, gid, mode := rm.ownership(0600)
+			sshOpts = append(sshOpts, llb.SSHSocketOpt(rm.Target, uid, gid, mode))
+		} else if rm.Target != "" {
+			sshOpts = append(sshOpts, llb.SSHSocketTarget(rm.Target))
 		}
 		return []llb.RunOption{llb.AddSSHSocket(sshOpts...)}, nil
 	case "secret":
-		if mountTarget == "" {
-			return nil, fmt.Errorf("mount target not specified")
-		}
-		secretID := strings.TrimPrefix(mountID, "+secrets/")
+		secretID := strings.TrimPrefix(rm.ID, "+secrets/")
+		// TODO: Perhaps this should just default to the current user automatically from
+		//       buildkit side. Then we wouldn't need to open this up to everyone.
+		uid, gid, mode := rm.ownership(0444)
 		secretOpts := []llb.SecretOption{
 			llb.SecretID(secretID),
-			// TODO: Perhaps this should just default to the current user automatically from
-			//       buildkit side. Then we wouldn't need to open this up to everyone.
-			llb.SecretFileOpt(0, 0, 0444),
+			llb.SecretFileOpt(uid, gid, mode),
 		}
-		return []llb.RunOption{llb.AddSecret(mountTarget, secretOpts...)}, nil
+		return []llb.RunOption{llb.AddSecret(rm.Target, secretOpts...)}, nil
 	default:
-		return nil, fmt.Errorf("invalid mount type %s", mountType)
+		return nil, fmt.Errorf("invalid mount type %s", rm.Type)
 	}
 }
 
+// mountFromState builds the target referenced via from= and returns its artifacts state,
+// together with the path of the artifact within it.
+func (c *Converter) mountFromState(ctx context.Context, rm *runMount) (llb.State, string, error) {
+	artifact, err := domain.ParseArtifact(rm.From)
+	if err != nil {
+		return llb.State{}, "", errors.Wrapf(err, "parse artifact name %s", rm.From)
+	}
+	mts, err := c.buildTarget(ctx, artifact.Target.String(), nil, nil, false)
+	if err != nil {
+		return llb.State{}, "", errors.Wrapf(err, "apply build %s", artifact.Target.String())
+	}
+	return mts.Final.ArtifactsState, path.Join("/", artifact.Artifact, rm.Source), nil
+}
+
 func cacheKeyTargetInput(ti dedup.TargetInput) (string, error) {
 	digest, err := ti.HashNoTag()
 	if err != nil {
@@ -182,3 +294,7 @@ func cacheKeyTargetInput(ti dedup.TargetInput) (string, error) {
 	}
 	return digest, nil
 }
+
+func intPtr(i int) *int {
+	return &i
+}
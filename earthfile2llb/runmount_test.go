@@ -0,0 +1,49 @@
+package earthfile2llb
+
+import (
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestParseRunMount(t *testing.T) {
+	var tests = []struct {
+		mount string
+		uid   int
+		gid   int
+		mode  int
+		from  string
+		ok    bool
+	}{
+		{"type=cache,target=/cache", 0, 0, 0755, "", true},
+		{"type=cache,target=/cache,uid=1000,gid=1000", 1000, 1000, 0755, "", true},
+		{"type=cache,target=/cache,mode=0700", 0, 0, 0700, "", true},
+		{"type=secret,target=/run/secret,id=+secrets/foo,uid=1000,mode=0400", 1000, 0, 0400, "", true},
+		{"type=bind,target=/src,from=+build/out", 0, 0, 0755, "+build/out", true},
+		{"type=cache,target=/cache,from=./sub+build/out", 0, 0, 0755, "./sub+build/out", true},
+		{"type=tmpfs,target=/tmp", 0, 0, 0755, "", true},
+		{"type=cache,target=/cache,uid=abc", 0, 0, 0, "", false},
+		{"type=cache,target=/cache,mode=999", 0, 0, 0, "", false},
+		{"type=cache,target=/cache,uid=1000,from=+build/out", 0, 0, 0, "", false},
+		{"type=tmpfs,target=/tmp,uid=1000", 0, 0, 0, "", false},
+		{"type=tmpfs,target=/tmp,from=+build/out", 0, 0, 0, "", false},
+		{"type=bind,target=/src,from=not-an-artifact", 0, 0, 0, "", false},
+		{"type=cache", 0, 0, 0, "", false},
+		{"target=/cache", 0, 0, 0, "", false},
+		{"type=unknown,target=/cache", 0, 0, 0, "", false},
+	}
+
+	for _, tt := range tests {
+		rm, err := parseRunMount(tt.mount)
+		if !tt.ok {
+			Error(t, err, tt.mount)
+			continue
+		}
+		NoError(t, err, tt.mount)
+		uid, gid, mode := rm.ownership(0755)
+		Equal(t, tt.uid, uid, tt.mount)
+		Equal(t, tt.gid, gid, tt.mount)
+		Equal(t, tt.mode, mode, tt.mount)
+		Equal(t, tt.from, rm.From, tt.mount)
+	}
+}
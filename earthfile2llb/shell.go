@@ -5,10 +5,15 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+
+	"github.com/alessio/shellescape"
 )
 
 const debuggerPath = "/usr/bin/earth_debugger"
 
+// defaultShell is the shell used for the shell form of commands, unless overridden via SHELL.
+var defaultShell = []string{"/bin/sh", "-c"}
+
 func splitWildcards(name string) (string, string) {
 	i := 0
 	for ; i < len(name); i++ {
@@ -30,14 +35,15 @@ func splitWildcards(name string) (string, string) {
 	return path.Dir(name[:i]), base + name[i:]
 }
 
-func withShell(args []string, withShell bool) []string {
+func withShell(args []string, shell []string, withShell bool) []string {
 	if withShell {
-		return []string{"/bin/sh", "-c", strings.Join(args, " ")}
+		ret := append([]string{}, shell...)
+		return append(ret, strings.Join(args, " "))
 	}
 	return args
 }
 
-func strWithEnvVarsAndDocker(args []string, envVars []string, withShell bool, withDebugger bool, withDocker bool) string {
+func strWithEnvVarsAndDocker(args []string, envVars []string, shell []string, withShell bool, withDebugger bool, withDocker bool) string {
 	var cmdParts []string
 	cmdParts = append(cmdParts, strings.Join(envVars, " "))
 	if withDocker {
@@ -51,7 +57,7 @@ func strWithEnvVarsAndDocker(args []string, envVars []string, withShell bool, wi
 		for _, arg := range args {
 			escapedArgs = append(escapedArgs, escapeShellSingleQuotes(arg))
 		}
-		cmdParts = append(cmdParts, "/bin/sh", "-c")
+		cmdParts = append(cmdParts, shellescape.QuoteCommand(shell))
 		cmdParts = append(cmdParts, fmt.Sprintf("'%s'", strings.Join(escapedArgs, " ")))
 	} else {
 		cmdParts = append(cmdParts, args...)
@@ -59,13 +65,20 @@ func strWithEnvVarsAndDocker(args []string, envVars []string, withShell bool, wi
 	return strings.Join(cmdParts, " ")
 }
 
-type shellWrapFun func(args []string, envVars []string, withShell bool, withDebugger bool) []string
+type shellWrapFun func(args []string, envVars []string, shell []string, withShell bool, withDebugger bool) []string
 
-func withShellAndEnvVars(args []string, envVars []string, withShell bool, withDebugger bool) []string {
-	return []string{
-		"/bin/sh", "-c",
-		strWithEnvVarsAndDocker(args, envVars, withShell, withDebugger, false),
-	}
+// withShellAndEnvVars sets up the env vars via the default shell, which then runs the command.
+// Only the shell form of the command is run with the given shell, such that the env var setup
+// does not depend on the SHELL being a POSIX one.
+func withShellAndEnvVars(args []string, envVars []string, shell []string, withShell bool, withDebugger bool) []string {
+	ret := append([]string{}, defaultShell...)
+	return append(ret, strWithEnvVarsAndDocker(args, envVars, shell, withShell, withDebugger, false))
+}
+
+// withDefaultShellAndEnvVars is like withShellAndEnvVars, but ignores any SHELL override. It is
+// used for the internal commands which rely on the POSIX shell syntax.
+func withDefaultShellAndEnvVars(args []string, envVars []string, shell []string, withShell bool, withDebugger bool) []string {
+	return withShellAndEnvVars(args, envVars, defaultShell, withShell, withDebugger)
 }
 
 func escapeShellSingleQuotes(arg string) string {
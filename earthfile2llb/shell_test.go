@@ -0,0 +1,44 @@
+package earthfile2llb
+
+import (
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestWithShellAndEnvVars(t *testing.T) {
+	var tests = []struct {
+		name      string
+		shell     []string
+		withShell bool
+		expected  []string
+	}{
+		{
+			"default shell",
+			defaultShell, true,
+			[]string{"/bin/sh", "-c", "A=1 /bin/sh -c 'echo '\"'\"'hi'\"'\"''"},
+		},
+		{
+			"custom shell",
+			[]string{"/bin/bash", "-eo", "pipefail", "-c"}, true,
+			[]string{"/bin/sh", "-c", "A=1 /bin/bash -eo pipefail -c 'echo '\"'\"'hi'\"'\"''"},
+		},
+		{
+			"non-POSIX shell",
+			[]string{"powershell", "-Command"}, true,
+			[]string{"/bin/sh", "-c", "A=1 powershell -Command 'echo '\"'\"'hi'\"'\"''"},
+		},
+		{
+			"exec form",
+			[]string{"/bin/bash", "-c"}, false,
+			[]string{"/bin/sh", "-c", "A=1 echo 'hi'"},
+		},
+	}
+	for _, tt := range tests {
+		actual := withShellAndEnvVars([]string{"echo", "'hi'"}, []string{"A=1"}, tt.shell, tt.withShell, false)
+		Equal(t, tt.expected, actual, tt.name)
+	}
+	Equal(t,
+		[]string{"/bin/sh", "-c", "A=1 /bin/sh -c 'true'"},
+		withDefaultShellAndEnvVars([]string{"true"}, []string{"A=1"}, []string{"/bin/bash", "-c"}, true, false))
+}
@@ -0,0 +1,313 @@
+package earthfile2llb
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/earthly/earthly/earthfile2llb/antlrhandler"
+	"github.com/earthly/earthly/earthfile2llb/parser"
+	"github.com/pkg/errors"
+)
+
+// SourceError is an error located within an Earthfile, such as a syntax error or an invalid
+// command. Its message includes a snippet of the offending line.
+type SourceError struct {
+	Filename string
+	// Line is the line of the error, starting at 1.
+	Line int
+	// Column is the column of the error, starting at 0.
+	Column int
+	// Token is the text of the offending token, if known.
+	Token string
+	Msg   string
+	// SourceLine is the text of the offending line, if available.
+	SourceLine string
+	// Suggestion is a close match for a misspelled command or flag, if any.
+	Suggestion string
+
+	err error
+}
+
+func (se *SourceError) Error() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s line %d:%d %s", se.Filename, se.Line, se.Column, se.Msg)
+	snippet := se.Snippet()
+	if snippet != "" {
+		sb.WriteString("\n")
+		sb.WriteString(snippet)
+	}
+	if se.Suggestion != "" {
+		fmt.Fprintf(&sb, "\nDid you mean %s?", se.Suggestion)
+	}
+	return sb.String()
+}
+
+// Unwrap returns the underlying error, if any.
+func (se *SourceError) Unwrap() error {
+	return se.err
+}
+
+// Snippet returns the offending line, followed by a line of carets pointing at the offending
+// token. It returns "" if the source line is not available.
+func (se *SourceError) Snippet() string {
+	if se.SourceLine == "" {
+		return ""
+	}
+	line := []rune(se.SourceLine)
+	col := se.Column
+	if col > len(line) {
+		col = len(line)
+	}
+	var caret strings.Builder
+	for _, r := range line[:col] {
+		// Preserve tabs, so that the caret lines up regardless of the tab width.
+		if r == '\t' {
+			caret.WriteRune('\t')
+		} else {
+			caret.WriteRune(' ')
+		}
+	}
+	length := len([]rune(strings.SplitN(se.Token, "\n", 2)[0]))
+	if length > len(line)-col {
+		length = len(line) - col
+	}
+	if length < 1 {
+		length = 1
+	}
+	caret.WriteString(strings.Repeat("^", length))
+	return fmt.Sprintf("    %s\n    %s", string(line), caret.String())
+}
+
+// suggestionError is an error about a misspelled token, along with a close match.
+type suggestionError struct {
+	err        error
+	token      string
+	suggestion string
+}
+
+func (se *suggestionError) Error() string {
+	return se.err.Error()
+}
+
+func (se *suggestionError) Unwrap() error {
+	return se.err
+}
+
+// knownCommands are the commands which may appear in an Earthfile, used for suggesting
+// corrections of misspelled commands.
+var knownCommands = []string{
+	"ADD", "ARG", "BUILD", "CMD", "COMMAND", "COPY", "DO", "ELSE", "END", "ENTRYPOINT", "ENV",
+	"EXPOSE", "FOR", "FROM", "GIT", "HEALTHCHECK", "IF", "IMPORT", "LABEL", "LOCALLY", "RUN",
+	"SAVE", "SHELL", "STOPSIGNAL", "USER", "VERSION", "VOLUME", "WITH", "WORKDIR",
+}
+
+// invalidCommandError returns the error for an unknown command, suggesting a close match if
+// there is one.
+func invalidCommandError(name string) error {
+	err := fmt.Errorf("invalid command %s", name)
+	suggestion := closestMatch(strings.ToUpper(name), knownCommands)
+	if suggestion == "" {
+		return err
+	}
+	return &suggestionError{err: err, token: name, suggestion: suggestion}
+}
+
+// parseFlags parses the flags of a command. If a flag is not defined, the error suggests
+// a close match among the flags of the flag set.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	err := fs.Parse(args)
+	if err == nil {
+		return nil
+	}
+	const undefinedPrefix = "flag provided but not defined: -"
+	if !strings.HasPrefix(err.Error(), undefinedPrefix) {
+		return err
+	}
+	name := strings.TrimPrefix(err.Error(), undefinedPrefix)
+	var candidates []string
+	fs.VisitAll(func(f *flag.Flag) {
+		candidates = append(candidates, f.Name)
+	})
+	suggestion := closestMatch(name, candidates)
+	if suggestion == "" {
+		return err
+	}
+	return &suggestionError{err: err, token: "-" + name, suggestion: "--" + suggestion}
+}
+
+// closestMatch returns the candidate closest to name, or "" if none is close enough to be
+// a likely misspelling.
+func closestMatch(name string, candidates []string) string {
+	ret := ""
+	best := 0
+	for _, c := range candidates {
+		if c == name {
+			return ""
+		}
+		d := editDistance(name, c)
+		// Allow roughly one typo per three characters, up to two in total.
+		maxDistance := len(c) / 3
+		if maxDistance < 1 {
+			maxDistance = 1
+		}
+		if maxDistance > 2 {
+			maxDistance = 2
+		}
+		if d > maxDistance {
+			continue
+		}
+		if ret == "" || d < best {
+			ret = c
+			best = d
+		}
+	}
+	return ret
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	cur := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		cur[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(br)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// syntaxError returns the first syntax error collected while parsing, if any.
+func syntaxError(filename string, errorListener *antlrhandler.ReturnErrorListener, errorStrategy *antlrhandler.ReturnErrorStrategy) error {
+	if err := unterminatedBlockError(filename, errorStrategy.ErrContext); err != nil {
+		return err
+	}
+	if len(errorListener.Errs) > 0 {
+		se, ok := errorListener.Errs[0].(*antlrhandler.SyntaxError)
+		if !ok {
+			return errorListener.Errs[0]
+		}
+		ret := newSourceError(filename, se.Line, se.Column, se.Token, errors.New("syntax error: "+se.Msg))
+		if len(errorListener.Errs) > 1 {
+			ret.Msg = fmt.Sprintf("%s (and %d more syntax errors)", ret.Msg, len(errorListener.Errs)-1)
+		}
+		return ret
+	}
+	if errorStrategy.Err != nil {
+		token := errorStrategy.RE.GetOffendingToken()
+		err := errorStrategy.Err
+		if errorStrategy.RE.GetMessage() != "" {
+			err = errors.Wrap(err, errorStrategy.RE.GetMessage())
+		}
+		return newSourceError(filename, token.GetLine(), token.GetColumn(), token.GetText(), errors.Wrap(err, "syntax error"))
+	}
+	return nil
+}
+
+// unterminatedBlockError returns an error pointing at the innermost IF, FOR or WITH DOCKER
+// statement enclosing the context of a syntax error, if that statement lacks its END.
+func unterminatedBlockError(filename string, ctx antlr.ParserRuleContext) error {
+	for ; ctx != nil; ctx, _ = ctx.GetParent().(antlr.ParserRuleContext) {
+		var name string
+		var end antlr.TerminalNode
+		switch c := ctx.(type) {
+		case *parser.IfStmtContext:
+			name, end = "IF", c.END()
+		case *parser.ForStmtContext:
+			name, end = "FOR", c.END()
+		case *parser.WithDockerStmtContext:
+			name, end = "WITH DOCKER", c.END()
+		default:
+			continue
+		}
+		if end != nil {
+			return nil
+		}
+		start := ctx.GetStart()
+		return newSourceError(filename, start.GetLine(), start.GetColumn(), start.GetText(),
+			fmt.Errorf("no matching END found for %s", name))
+	}
+	return nil
+}
+
+// stmtError locates an error which occurred while processing the statement (or target header)
+// starting at the given token. If the error is already located, it is returned unchanged.
+func stmtError(filename string, start antlr.Token, err error) error {
+	var se *SourceError
+	if start == nil || errors.As(err, &se) {
+		return err
+	}
+	ret := newSourceError(filename, start.GetLine(), start.GetColumn(), start.GetText(), err)
+	var sugg *suggestionError
+	if errors.As(err, &sugg) {
+		ret.Suggestion = sugg.suggestion
+		// Point at the misspelled token, if it is part of the first line of the statement.
+		line := []rune(ret.SourceLine)
+		if ret.Column < len(line) {
+			rest := string(line[ret.Column:])
+			i := strings.Index(rest, sugg.token)
+			if i != -1 {
+				token := sugg.token
+				// Flags may be written with either one or two dashes.
+				for i > 0 && rest[i-1] == '-' {
+					i--
+					token = "-" + token
+				}
+				ret.Column += len([]rune(rest[:i]))
+				ret.Token = token
+			}
+		}
+	}
+	return ret
+}
+
+func newSourceError(filename string, line, column int, token string, err error) *SourceError {
+	return &SourceError{
+		Filename:   filename,
+		Line:       line,
+		Column:     column,
+		Token:      token,
+		Msg:        err.Error(),
+		SourceLine: readSourceLine(filename, line),
+		err:        err,
+	}
+}
+
+// readSourceLine returns the given line (starting at 1) of the file, or "" if it cannot be read.
+func readSourceLine(filename string, line int) string {
+	f, err := os.Open(filename)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for i := 1; scanner.Scan(); i++ {
+		if i == line {
+			return strings.TrimRight(scanner.Text(), "\r")
+		}
+	}
+	return ""
+}
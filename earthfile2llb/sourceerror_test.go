@@ -0,0 +1,75 @@
+package earthfile2llb
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/pkg/errors"
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestClosestMatch(t *testing.T) {
+	var tests = []struct {
+		name       string
+		candidates []string
+		expected   string
+	}{
+		{"COPPY", knownCommands, "COPY"},
+		{"RUNN", knownCommands, "RUN"},
+		{"WORKDRI", knownCommands, "WORKDIR"},
+		{"COPY", knownCommands, ""},
+		{"FOOBAR", knownCommands, ""},
+		{"bild-arg", []string{"build-arg", "platform"}, "build-arg"},
+		{"x", []string{"dir", "chown"}, ""},
+	}
+	for _, tt := range tests {
+		Equal(t, tt.expected, closestMatch(tt.name, tt.candidates), tt.name)
+	}
+}
+
+func TestParseFlagsSuggestion(t *testing.T) {
+	fs := flag.NewFlagSet("COPY", flag.ContinueOnError)
+	fs.Bool("dir", false, "")
+	fs.String("platform", "", "")
+	err := parseFlags(fs, []string{"--platfrom", "linux/amd64", "src", "dest"})
+	var sugg *suggestionError
+	if True(t, errors.As(err, &sugg)) {
+		Equal(t, "--platform", sugg.suggestion)
+		Equal(t, "-platfrom", sugg.token)
+	}
+}
+
+func TestSourceErrorSnippet(t *testing.T) {
+	se := &SourceError{
+		Filename:   "Earthfile",
+		Line:       3,
+		Column:     5,
+		Token:      "COPPY",
+		Msg:        "invalid command COPPY",
+		SourceLine: "\t    COPPY a b",
+		Suggestion: "COPY",
+	}
+	Equal(t, "Earthfile line 3:5 invalid command COPPY\n"+
+		"    \t    COPPY a b\n"+
+		"    \t    ^^^^^\n"+
+		"Did you mean COPY?", se.Error())
+}
+
+func TestSyntaxSourceError(t *testing.T) {
+	_, err := formatString(t, "build:\nother:\n    RUN echo hi\n")
+	var se *SourceError
+	if True(t, errors.As(err, &se)) {
+		Equal(t, 2, se.Line)
+		Equal(t, "other:", se.SourceLine)
+		Contains(t, se.Error(), "syntax error")
+	}
+}
+
+func TestUnterminatedBlockSourceError(t *testing.T) {
+	_, err := formatString(t, "build:\n    IF true\n        RUN true\n\nother:\n    RUN true\n")
+	var se *SourceError
+	if True(t, errors.As(err, &se)) {
+		Equal(t, 2, se.Line)
+		Equal(t, "no matching END found for IF", se.Msg)
+	}
+}
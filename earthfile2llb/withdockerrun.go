@@ -121,8 +121,7 @@ func (wdr *withDockerRun) Run(ctx context.Context, args []string, opt WithDocker
 		}
 	}
 	var runOpts []llb.RunOption
-	mountRunOpts, err := parseMounts(
-		opt.Mounts, wdr.c.mts.Final.Target, wdr.c.mts.Final.TargetInput, wdr.c.cacheContext)
+	mountRunOpts, err := wdr.c.parseMounts(ctx, opt.Mounts)
 	if err != nil {
 		return errors.Wrap(err, "parse mounts")
 	}
@@ -359,12 +358,10 @@ func makeWithDockerdWrapFun(dindID string, tarPaths []string, opt WithDockerOpt)
 		fmt.Sprintf("EARTHLY_DOCKER_LOAD_FILES=\"%s\"", strings.Join(tarPaths, " ")),
 	}
 	params = append(params, composeParams(opt)...)
-	return func(args []string, envVars []string, isWithShell bool, withDebugger bool) []string {
+	return func(args []string, envVars []string, shell []string, isWithShell bool, withDebugger bool) []string {
 		envVars2 := append(params, envVars...)
-		return []string{
-			"/bin/sh", "-c",
-			strWithEnvVarsAndDocker(args, envVars2, isWithShell, withDebugger, true),
-		}
+		ret := append([]string{}, defaultShell...)
+		return append(ret, strWithEnvVarsAndDocker(args, envVars2, shell, isWithShell, withDebugger, true))
 	}
 }
 
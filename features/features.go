@@ -0,0 +1,117 @@
+// Package features defines the Earthfile language features which depend on the VERSION
+// declared by an Earthfile.
+package features
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// Version is an Earthfile version, as declared via VERSION <major>.<minor>.
+type Version struct {
+	Major int
+	Minor int
+}
+
+// Latest is the most recent Earthfile version supported by this binary.
+var Latest = Version{Major: 0, Minor: 5}
+
+var versionRegexp = regexp.MustCompile(`^([0-9]+)\.([0-9]+)$`)
+
+// ParseVersion parses a version of the form <major>.<minor> (e.g. 0.5).
+func ParseVersion(s string) (Version, error) {
+	m := versionRegexp.FindStringSubmatch(s)
+	if m == nil {
+		return Version{}, errors.Errorf("invalid version %s, expected <major>.<minor> (e.g. %s)", s, Latest)
+	}
+	major, err := strconv.Atoi(m[1])
+	if err != nil {
+		return Version{}, errors.Wrapf(err, "parse major version %s", s)
+	}
+	minor, err := strconv.Atoi(m[2])
+	if err != nil {
+		return Version{}, errors.Wrapf(err, "parse minor version %s", s)
+	}
+	return Version{Major: major, Minor: minor}, nil
+}
+
+// AtLeast returns whether the version is the same or newer than other.
+func (v Version) AtLeast(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor >= other.Minor
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Feature is a language feature which is only available from a certain version onwards.
+type Feature struct {
+	Name  string
+	Since Version
+}
+
+var (
+	// UserCommands enables COMMAND and DO.
+	UserCommands = Feature{Name: "COMMAND and DO", Since: Version{0, 5}}
+	// IfCommand enables IF, ELSE IF and ELSE.
+	IfCommand = Feature{Name: "IF", Since: Version{0, 5}}
+	// ForCommand enables FOR.
+	ForCommand = Feature{Name: "FOR", Since: Version{0, 5}}
+	// Locally enables LOCALLY.
+	Locally = Feature{Name: "LOCALLY", Since: Version{0, 5}}
+	// Imports enables IMPORT.
+	Imports = Feature{Name: "IMPORT", Since: Version{0, 5}}
+	// Heredocs enables heredocs in RUN and COPY.
+	Heredocs = Feature{Name: "heredocs", Since: Version{0, 5}}
+	// AddCommand enables ADD.
+	AddCommand = Feature{Name: "ADD", Since: Version{0, 5}}
+	// ShellCommands enables SHELL and STOPSIGNAL.
+	ShellCommands = Feature{Name: "SHELL and STOPSIGNAL", Since: Version{0, 5}}
+	// MountOptions enables RUN --mount type=bind, as well as the from, uid, gid and mode options.
+	MountOptions = Feature{Name: "RUN --mount type=bind, from, uid, gid and mode", Since: Version{0, 5}}
+)
+
+// Features is the set of language features available to an Earthfile.
+type Features struct {
+	// Version is the version the features correspond to.
+	Version Version
+}
+
+// Default returns the features available to Earthfiles which do not declare a VERSION.
+func Default() *Features {
+	return &Features{Version: Latest}
+}
+
+// Get returns the features available to an Earthfile declaring the given VERSION.
+func Get(versionStr string) (*Features, error) {
+	v, err := ParseVersion(versionStr)
+	if err != nil {
+		return nil, err
+	}
+	if !Latest.AtLeast(v) {
+		return nil, errors.Errorf(
+			"the Earthfile requires VERSION %s, but this earthly binary only supports up to VERSION %s; please upgrade earthly",
+			v, Latest)
+	}
+	return &Features{Version: v}, nil
+}
+
+// Enabled returns whether the feature is available.
+func (fs *Features) Enabled(f Feature) bool {
+	return fs.Version.AtLeast(f.Since)
+}
+
+// Check returns an error if the feature is not available.
+func (fs *Features) Check(f Feature) error {
+	if fs.Enabled(f) {
+		return nil
+	}
+	return errors.Errorf(
+		"%s requires VERSION %s or later, but the Earthfile declares VERSION %s", f.Name, f.Since, fs.Version)
+}
@@ -0,0 +1,46 @@
+package features
+
+import (
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	var tests = []struct {
+		version  string
+		expected Version
+		ok       bool
+	}{
+		{"0.5", Version{0, 5}, true},
+		{"0.4", Version{0, 4}, true},
+		{"0.10", Version{}, false},
+		{"1.0", Version{}, false},
+		{"0.5.1", Version{}, false},
+		{"v0.5", Version{}, false},
+		{"", Version{}, false},
+	}
+	for _, tt := range tests {
+		fs, err := Get(tt.version)
+		if !tt.ok {
+			Error(t, err, tt.version)
+			continue
+		}
+		NoError(t, err, tt.version)
+		Equal(t, tt.expected, fs.Version, tt.version)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	fs, err := Get("0.4")
+	NoError(t, err)
+	False(t, fs.Enabled(IfCommand))
+	Error(t, fs.Check(IfCommand))
+
+	fs, err = Get(Latest.String())
+	NoError(t, err)
+	True(t, fs.Enabled(IfCommand))
+	NoError(t, fs.Check(IfCommand))
+
+	True(t, Default().Enabled(Heredocs))
+}
@@ -3,6 +3,7 @@ package llbutil
 import (
 	"context"
 	"fmt"
+	"path"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -14,6 +15,31 @@ import (
 
 // CopyOp is a simplified llb copy operation.
 func CopyOp(srcState llb.State, srcs []string, destState llb.State, dest string, allowWildcard bool, isDir bool, keepTs bool, chown string, ifExists bool, opts ...llb.ConstraintsOpt) llb.State {
+	return copyOp(srcState, srcs, destState, dest, allowWildcard, isDir, keepTs, chown, ifExists, false, opts...)
+}
+
+// AddOp is a simplified llb copy operation, which also unpacks any tar archives being copied,
+// similarly to the Dockerfile ADD command.
+func AddOp(srcState llb.State, srcs []string, destState llb.State, dest string, allowWildcard bool, keepTs bool, chown string, opts ...llb.ConstraintsOpt) llb.State {
+	return copyOp(srcState, srcs, destState, dest, allowWildcard, false, keepTs, chown, false, true, opts...)
+}
+
+// MkfileOp is a simplified llb operation which creates a file with the given contents,
+// together with any missing parent directories.
+func MkfileOp(destState llb.State, dest string, data []byte, keepTs bool, chown string, opts ...llb.ConstraintsOpt) llb.State {
+	var mkfileOpts []llb.MkfileOption
+	if chown != "" {
+		mkfileOpts = append(mkfileOpts, llb.WithUser(chown))
+	}
+	if !keepTs {
+		mkfileOpts = append(mkfileOpts, llb.WithCreatedTime(*defaultTs()))
+	}
+	fa := llb.Mkdir(path.Dir(dest), 0755, llb.WithParents(true)).
+		Mkfile(dest, 0644, data, mkfileOpts...)
+	return destState.File(fa, opts...)
+}
+
+func copyOp(srcState llb.State, srcs []string, destState llb.State, dest string, allowWildcard bool, isDir bool, keepTs bool, chown string, ifExists bool, attemptUnpack bool, opts ...llb.ConstraintsOpt) llb.State {
 	destAdjusted := dest
 	if dest == "." || dest == "" || strings.HasSuffix(dest, string(filepath.Separator)) {
 		destAdjusted += string(filepath.Separator)
@@ -39,7 +65,7 @@ func CopyOp(srcState llb.State, srcs []string, destState llb.State, dest string,
 			&llb.CopyInfo{
 				FollowSymlinks:      true,
 				CopyDirContentsOnly: !isDir,
-				AttemptUnpack:       false,
+				AttemptUnpack:       attemptUnpack,
 				CreateDestPath:      true,
 				AllowWildcard:       allowWildcard,
 				AllowEmptyWildcard:  ifExists,
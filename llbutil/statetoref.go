@@ -12,6 +12,17 @@ import (
 
 // StateToRef takes an LLB state, solves it using gateway and returns the ref.
 func StateToRef(ctx context.Context, gwClient gwclient.Client, state llb.State, platform *specs.Platform, cacheImports map[string]bool) (gwclient.Reference, error) {
+	return solveState(ctx, gwClient, state, platform, cacheImports, false)
+}
+
+// EvaluateState takes an LLB state and builds it right away using gateway, rather than
+// lazily, when the ref is first used.
+func EvaluateState(ctx context.Context, gwClient gwclient.Client, state llb.State, platform *specs.Platform, cacheImports map[string]bool) error {
+	_, err := solveState(ctx, gwClient, state, platform, cacheImports, true)
+	return err
+}
+
+func solveState(ctx context.Context, gwClient gwclient.Client, state llb.State, platform *specs.Platform, cacheImports map[string]bool, evaluate bool) (gwclient.Reference, error) {
 	cacheImportsSlice := make([]string, 0, len(cacheImports))
 	for ci := range cacheImports {
 		cacheImportsSlice = append(cacheImportsSlice, ci)
@@ -30,6 +41,7 @@ func StateToRef(ctx context.Context, gwClient gwclient.Client, state llb.State,
 		return nil, errors.Wrap(err, "marshal state")
 	}
 	r, err := gwClient.Solve(ctx, gwclient.SolveRequest{
+		Evaluate:     evaluate,
 		Definition:   def.ToPB(),
 		CacheImports: coes,
 	})
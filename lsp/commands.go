@@ -0,0 +1,166 @@
+package lsp
+
+import (
+	"sort"
+	"strings"
+)
+
+// command describes an Earthfile command, for completion and hover.
+type command struct {
+	synopsis    string
+	description string
+}
+
+// commands are the Earthfile commands, as documented in docs/earthfile/earthfile.md.
+var commands = map[string]command{
+	"VERSION": {
+		"VERSION <major>.<minor>",
+		"Identifies the set of features available to the Earthfile. It must be the first command of the Earthfile.",
+	},
+	"FROM": {
+		"FROM [--build-arg <key>=<value>] [--platform <platform>] <image-name>|<target-ref>",
+		"Initializes a new build environment, inheriting from an existing image or target.",
+	},
+	"FROM DOCKERFILE": {
+		"FROM DOCKERFILE [--build-arg <key>=<value>] [--target <target-name>] [-f <dockerfile-path>] <context-path>",
+		"Initializes a new build environment, inheriting from an existing Dockerfile.",
+	},
+	"LOCALLY": {
+		"LOCALLY",
+		"Runs the subsequent commands of the target on the host, rather than within a container.",
+	},
+	"RUN": {
+		"RUN [--push] [--privileged] [--entrypoint] [--secret <env-var>=<secret-ref>] [--ssh] [--mount <mount-spec>] [--] <command>",
+		"Runs a command in the build environment of the current target, in a new layer.",
+	},
+	"COPY": {
+		"COPY [--dir] [--chown <user:group>] [--keep-ts] [--keep-own] [--if-exists] [--platform <platform>] [--build-arg <key>=<value>] <src>... <dest>",
+		"Copies files from the build context, or artifacts from other targets, into the build environment.",
+	},
+	"GIT CLONE": {
+		"GIT CLONE [--branch <git-ref>] [--keep-ts] <git-url> <dest-path>",
+		"Clones a git repository into the build environment.",
+	},
+	"SAVE ARTIFACT": {
+		"SAVE ARTIFACT [--keep-ts] [--keep-own] [--if-exists] <src> [<artifact-dest-path>] [AS LOCAL <local-path>]",
+		"Saves a file or directory as an artifact of the target, optionally also copying it to the host.",
+	},
+	"SAVE IMAGE": {
+		"SAVE IMAGE [--push] [--cache-from <cache-image>] [--cache-hint] <image-name>...",
+		"Marks the build environment as the image of the target, optionally naming it.",
+	},
+	"BUILD": {
+		"BUILD [--build-arg <key>=<value>] [--platform <platform>] <target-ref>",
+		"Builds the referenced target along with the current one.",
+	},
+	"ARG": {
+		"ARG <name>[=<default-value>]",
+		"Declares a build arg, which may be overridden by the caller of the target.",
+	},
+	"WITH DOCKER": {
+		"WITH DOCKER [--pull <image-name>] [--load <image-name>=<target-ref>] [--compose <compose-file>] [--service <compose-service>] [--build-arg <key>=<value>]\n  RUN ...\nEND",
+		"Runs the enclosed RUN command in a container with a docker daemon available.",
+	},
+	"IF": {
+		"IF [<options>...] <condition>\n  <if-block>\n[ELSE IF [<options>...] <condition>\n  <else-if-block>]...\n[ELSE\n  <else-block>]\nEND",
+		"Runs a block of commands if the condition command succeeds.",
+	},
+	"ELSE": {
+		"ELSE [IF [<options>...] <condition>]",
+		"Starts the block of an IF which runs if the previous conditions fail.",
+	},
+	"FOR": {
+		"FOR [<options>...] <variable-name> IN <expression>\n  <for-block>\nEND",
+		"Runs a block of commands for each word of the expression.",
+	},
+	"END": {
+		"END",
+		"Ends an IF, FOR or WITH DOCKER block.",
+	},
+	"COMMAND": {
+		"<COMMAND-NAME>:\n    COMMAND\n    <recipe>",
+		"Marks the target as a user-defined command, which is invoked via DO.",
+	},
+	"DO": {
+		"DO <command-ref> [--<build-arg-key>=<build-arg-value>...]",
+		"Runs the recipe of a user-defined command in the current build environment.",
+	},
+	"IMPORT": {
+		"IMPORT <project-ref> [AS <alias>]",
+		"Aliases a project reference, so that its targets can be referenced via the alias.",
+	},
+	"ADD": {
+		"ADD [--chown <user>:<group>] [--keep-ts] [--checksum <digest>] <src>... <dest>",
+		"Same as the Dockerfile ADD command.",
+	},
+	"CMD": {
+		"CMD [\"executable\", \"arg1\", \"arg2\"]",
+		"Same as the Dockerfile CMD command.",
+	},
+	"LABEL": {
+		"LABEL <key>=<value> <key>=<value> ...",
+		"Same as the Dockerfile LABEL command.",
+	},
+	"EXPOSE": {
+		"EXPOSE <port>[/<protocol>] ...",
+		"Same as the Dockerfile EXPOSE command.",
+	},
+	"ENV": {
+		"ENV <key>=<value>",
+		"Same as the Dockerfile ENV command.",
+	},
+	"ENTRYPOINT": {
+		"ENTRYPOINT [\"executable\", \"arg1\", \"arg2\"]",
+		"Same as the Dockerfile ENTRYPOINT command.",
+	},
+	"VOLUME": {
+		"VOLUME <path-to-target-mount> ...",
+		"Same as the Dockerfile VOLUME command.",
+	},
+	"USER": {
+		"USER <user>[:<group>]",
+		"Same as the Dockerfile USER command.",
+	},
+	"WORKDIR": {
+		"WORKDIR <path-to-dir>",
+		"Same as the Dockerfile WORKDIR command.",
+	},
+	"HEALTHCHECK": {
+		"HEALTHCHECK [--interval=DURATION] [--timeout=DURATION] [--start-period=DURATION] [--retries=N] CMD command arg1 arg2",
+		"Same as the Dockerfile HEALTHCHECK command.",
+	},
+	"SHELL": {
+		"SHELL [\"executable\", \"parameters\"]",
+		"Same as the Dockerfile SHELL command.",
+	},
+	"STOPSIGNAL": {
+		"STOPSIGNAL <signal>",
+		"Same as the Dockerfile STOPSIGNAL command.",
+	},
+}
+
+// commandNames returns the names of the commands, sorted.
+func commandNames() []string {
+	var ret []string
+	for name := range commands {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// lineCommand returns the command at the start of the (trimmed) line, or "" if there is none.
+// The longest matching command wins, so that e.g. FROM DOCKERFILE is not mistaken for FROM.
+func lineCommand(line string) string {
+	ret := ""
+	for name := range commands {
+		if !strings.HasPrefix(line, name) || len(name) <= len(ret) {
+			continue
+		}
+		rest := line[len(name):]
+		if rest == "" || rest[0] == ' ' || rest[0] == '\t' {
+			ret = name
+		}
+	}
+	return ret
+}
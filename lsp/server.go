@@ -0,0 +1,439 @@
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/earthly/earthly/domain"
+	"github.com/earthly/earthly/earthfile2llb"
+	"github.com/earthly/earthly/fileutil"
+	"github.com/pkg/errors"
+)
+
+// Server is a language server for Earthfiles, speaking the Language Server Protocol over a
+// pair of streams (typically stdin and stdout). It provides diagnostics for syntax errors,
+// completion of commands, flags, targets and args, hover docs for commands and go-to-definition
+// for target references.
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+	// docs are the contents of the open documents, by URI.
+	docs     map[string]string
+	shutdown bool
+}
+
+// NewServer returns a new language server reading requests from in and writing responses to
+// out.
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		in:   bufio.NewReader(in),
+		out:  out,
+		docs: make(map[string]string),
+	}
+}
+
+// Serve handles the messages of the client, until it exits or closes the input.
+func (s *Server) Serve(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		msg, err := s.read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("exit requested without shutdown")
+			}
+			return nil
+		}
+		result, err := s.handle(msg)
+		rerr, ok := err.(*responseError)
+		if err != nil && !ok {
+			return err
+		}
+		if msg.ID == nil {
+			// Notifications get no response.
+			continue
+		}
+		err = s.respond(msg.ID, result, rerr)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// handle handles a message, returning the result of the request, if any. Errors to be
+// reported to the client are of type *responseError; other errors are fatal.
+func (s *Server) handle(msg *message) (interface{}, error) {
+	switch msg.Method {
+	case "initialize":
+		var res initializeResult
+		res.Capabilities.TextDocumentSync = textDocumentSyncFull
+		res.Capabilities.HoverProvider = true
+		res.Capabilities.DefinitionProvider = true
+		res.Capabilities.CompletionProvider.TriggerCharacters = []string{"+", "-", "$"}
+		res.ServerInfo.Name = "earthly"
+		return res, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		s.docs[params.TextDocument.URI] = params.TextDocument.Text
+		return nil, s.publishDiagnostics(params.TextDocument.URI)
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		// Documents are synced in full, so the last change holds the whole document.
+		if len(params.ContentChanges) > 0 {
+			s.docs[params.TextDocument.URI] = params.ContentChanges[len(params.ContentChanges)-1].Text
+		}
+		return nil, s.publishDiagnostics(params.TextDocument.URI)
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []diagnostic{},
+		})
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return s.completion(params), nil
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return s.hover(params), nil
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if err := unmarshalParams(msg, &params); err != nil {
+			return nil, err
+		}
+		return s.definition(params), nil
+	default:
+		if msg.ID == nil {
+			return nil, nil
+		}
+		return nil, &responseError{
+			Code:    codeMethodNotFound,
+			Message: fmt.Sprintf("method %s not supported", msg.Method),
+		}
+	}
+}
+
+func (s *Server) publishDiagnostics(uri string) error {
+	diagnostics := []diagnostic{}
+	for _, se := range earthfile2llb.ParseOutline(s.docs[uri]).Errors {
+		pos := position{Line: se.Line - 1, Character: se.Column}
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    lspRange{Start: pos, End: pos},
+			Severity: severityError,
+			Source:   "earthly",
+			Message:  se.Msg,
+		})
+	}
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diagnostics,
+	})
+}
+
+func (s *Server) completion(params textDocumentPositionParams) completionList {
+	ret := completionList{Items: []completionItem{}}
+	line := s.line(params.TextDocument.URI, params.Position.Line)
+	before := line
+	if params.Position.Character < len(line) {
+		before = line[:params.Position.Character]
+	}
+	word := before[strings.LastIndexAny(before, " \t")+1:]
+	trimmed := strings.TrimLeft(before, " \t")
+	switch {
+	case trimmed == word:
+		for _, name := range commandNames() {
+			ret.Items = append(ret.Items, completionItem{
+				Label:  name,
+				Kind:   completionKindKeyword,
+				Detail: commands[name].synopsis,
+			})
+		}
+	case strings.HasPrefix(word, "-"):
+		for _, flag := range earthfile2llb.CommandFlags(lineCommand(trimmed)) {
+			ret.Items = append(ret.Items, completionItem{
+				Label: "--" + flag,
+				Kind:  completionKindProperty,
+			})
+		}
+	case strings.Contains(word, "$"):
+		outline := earthfile2llb.ParseOutline(s.docs[params.TextDocument.URI])
+		target := ""
+		if t := outline.TargetAt(params.Position.Line + 1); t != nil {
+			target = t.Name
+		}
+		seen := make(map[string]bool)
+		for _, arg := range outline.Args {
+			if (arg.Target != "" && arg.Target != target) || seen[arg.Name] {
+				continue
+			}
+			seen[arg.Name] = true
+			ret.Items = append(ret.Items, completionItem{
+				Label: arg.Name,
+				Kind:  completionKindVariable,
+			})
+		}
+	case strings.Contains(word, "+"):
+		ref := word[strings.LastIndex(word, "=")+1:]
+		dir := ref[:strings.Index(ref, "+")]
+		targets, err := s.targets(params.TextDocument.URI, dir)
+		if err != nil {
+			return ret
+		}
+		for _, target := range targets {
+			ret.Items = append(ret.Items, completionItem{
+				Label: target,
+				Kind:  completionKindFunction,
+			})
+		}
+	}
+	return ret
+}
+
+func (s *Server) hover(params textDocumentPositionParams) interface{} {
+	line := s.line(params.TextDocument.URI, params.Position.Line)
+	trimmed := strings.TrimLeft(line, " \t")
+	indent := len(line) - len(trimmed)
+	name := lineCommand(trimmed)
+	if name == "" || params.Position.Character < indent ||
+		params.Position.Character > indent+len(name) {
+		return nil
+	}
+	cmd, ok := commands[name]
+	if !ok {
+		return nil
+	}
+	return hover{
+		Contents: markupContent{
+			Kind:  "markdown",
+			Value: fmt.Sprintf("```\n%s\n```\n\n%s", cmd.synopsis, cmd.description),
+		},
+		Range: &lspRange{
+			Start: position{Line: params.Position.Line, Character: indent},
+			End:   position{Line: params.Position.Line, Character: indent + len(name)},
+		},
+	}
+}
+
+func (s *Server) definition(params textDocumentPositionParams) interface{} {
+	line := s.line(params.TextDocument.URI, params.Position.Line)
+	col := params.Position.Character
+	if col > len(line) {
+		return nil
+	}
+	start := strings.LastIndexAny(line[:col], " \t=\"'") + 1
+	end := len(line)
+	if i := strings.IndexAny(line[col:], " \t\"'"); i != -1 {
+		end = col + i
+	}
+	ref := line[start:end]
+	if !strings.Contains(ref, "+") || strings.Contains(ref, "$") {
+		return nil
+	}
+	target, err := domain.ParseTarget(ref)
+	if artifact, aerr := domain.ParseArtifact(ref); aerr == nil {
+		target, err = artifact.Target, nil
+	}
+	if err != nil || !(target.IsLocalInternal() || target.IsLocalExternal()) {
+		return nil
+	}
+	uri := params.TextDocument.URI
+	content := s.docs[uri]
+	if target.IsLocalExternal() {
+		filename, err := s.earthfile(uri, target.LocalPath)
+		if err != nil {
+			return nil
+		}
+		uri = (&url.URL{Scheme: "file", Path: filename}).String()
+		content, err = s.content(uri)
+		if err != nil {
+			return nil
+		}
+	}
+	t := earthfile2llb.ParseOutline(content).Target(target.Target)
+	if t == nil {
+		return nil
+	}
+	pos := position{Line: t.Line - 1, Character: t.Column}
+	return []location{{
+		URI:   uri,
+		Range: lspRange{Start: pos, End: position{Line: pos.Line, Character: pos.Character + len(t.Name)}},
+	}}
+}
+
+// targets returns the targets of the Earthfile in the given directory, relative to the
+// document. An empty directory stands for the document itself.
+func (s *Server) targets(uri string, dir string) ([]string, error) {
+	if dir == "" {
+		var ret []string
+		for _, t := range earthfile2llb.ParseOutline(s.docs[uri]).Targets {
+			ret = append(ret, t.Name)
+		}
+		return ret, nil
+	}
+	filename, err := s.earthfile(uri, dir)
+	if err != nil {
+		return nil, err
+	}
+	return earthfile2llb.GetTargets(filename)
+}
+
+// earthfile returns the path of the Earthfile in the given directory, relative to the document.
+func (s *Server) earthfile(uri string, dir string) (string, error) {
+	docPath, err := uriPath(uri)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(filepath.Dir(docPath), dir)
+	}
+	for _, name := range []string{"Earthfile", "build.earth"} {
+		filename := filepath.Join(dir, name)
+		if fileutil.FileExists(filename) {
+			return filename, nil
+		}
+	}
+	return "", fmt.Errorf("no Earthfile nor build.earth file found in %s", dir)
+}
+
+// content returns the contents of the document, which need not be open.
+func (s *Server) content(uri string) (string, error) {
+	content, ok := s.docs[uri]
+	if ok {
+		return content, nil
+	}
+	filename, err := uriPath(uri)
+	if err != nil {
+		return "", err
+	}
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", errors.Wrapf(err, "read %s", filename)
+	}
+	return string(b), nil
+}
+
+// line returns the given line (starting at 0) of the document. Characters within the line are
+// addressed by byte offset, which matches the UTF-16 offsets of the protocol for ASCII text.
+func (s *Server) line(uri string, line int) string {
+	lines := strings.Split(s.docs[uri], "\n")
+	if line < 0 || line >= len(lines) {
+		return ""
+	}
+	return strings.TrimSuffix(lines[line], "\r")
+}
+
+func uriPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", errors.Wrapf(err, "parse uri %s", uri)
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported uri %s", uri)
+	}
+	return filepath.FromSlash(u.Path), nil
+}
+
+func unmarshalParams(msg *message, params interface{}) error {
+	err := json.Unmarshal(msg.Params, params)
+	if err != nil {
+		return &responseError{
+			Code:    codeInvalidParams,
+			Message: fmt.Sprintf("invalid params for %s: %v", msg.Method, err),
+		}
+	}
+	return nil
+}
+
+// read reads a message, consisting of headers followed by a JSON body.
+func (s *Server) read() (*message, error) {
+	headers, err := textproto.NewReader(s.in).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrap(err, "read headers")
+	}
+	length, err := strconv.Atoi(headers.Get("Content-Length"))
+	if err != nil {
+		return nil, errors.Wrap(err, "parse Content-Length header")
+	}
+	body := make([]byte, length)
+	_, err = io.ReadFull(s.in, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body")
+	}
+	msg := new(message)
+	err = json.Unmarshal(body, msg)
+	if err != nil {
+		// The request id is unknown, so no response can be sent.
+		return nil, errors.Wrapf(err, "unmarshal message (code %d)", codeParseError)
+	}
+	return msg, nil
+}
+
+func (s *Server) respond(id *json.RawMessage, result interface{}, rerr *responseError) error {
+	msg := &message{ID: id, Error: rerr}
+	if rerr == nil {
+		b, err := json.Marshal(result)
+		if err != nil {
+			return errors.Wrap(err, "marshal result")
+		}
+		raw := json.RawMessage(b)
+		msg.Result = &raw
+	}
+	return s.write(msg)
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return errors.Wrap(err, "marshal params")
+	}
+	return s.write(&message{Method: method, Params: b})
+}
+
+func (s *Server) write(msg *message) error {
+	msg.JSONRPC = "2.0"
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "marshal message")
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return errors.Wrap(err, "write message")
+}
@@ -0,0 +1,151 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	. "github.com/stretchr/testify/assert"
+)
+
+const testEarthfile = `FROM alpine:3.11
+ARG GLOBAL
+
+build:
+    ARG VERSION
+    RUN echo $
+    BUILD ./sub+sub
+    COPY +build/out ./
+
+other:
+    FROM +build
+    RUN --
+`
+
+func TestServer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "earthly-lsp")
+	NoError(t, err)
+	defer os.RemoveAll(dir)
+	NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0755))
+	subEarthfile := filepath.Join(dir, "sub", "Earthfile")
+	NoError(t, ioutil.WriteFile(subEarthfile, []byte("FROM alpine:3.11\n\nsub:\n    RUN true\n"), 0644))
+	uri := (&url.URL{Scheme: "file", Path: filepath.Join(dir, "Earthfile")}).String()
+	subURI := (&url.URL{Scheme: "file", Path: subEarthfile}).String()
+
+	doc := map[string]interface{}{"uri": uri}
+	at := func(line, character int) map[string]interface{} {
+		return map[string]interface{}{
+			"textDocument": doc,
+			"position":     map[string]int{"line": line, "character": character},
+		}
+	}
+	var in bytes.Buffer
+	requests := []struct {
+		id     int
+		method string
+		params interface{}
+	}{
+		{1, "initialize", map[string]interface{}{}},
+		{0, "textDocument/didOpen", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": uri, "languageId": "earthfile", "version": 1, "text": testEarthfile},
+		}},
+		{2, "textDocument/completion", at(4, 4)},
+		{3, "textDocument/completion", at(5, 14)},
+		{4, "textDocument/completion", at(11, 10)},
+		{5, "textDocument/completion", at(10, 10)},
+		{6, "textDocument/hover", at(5, 5)},
+		{7, "textDocument/definition", at(6, 14)},
+		{8, "textDocument/definition", at(7, 12)},
+		{9, "textDocument/unknown", at(0, 0)},
+		{0, "textDocument/didChange", map[string]interface{}{
+			"textDocument":   doc,
+			"contentChanges": []map[string]string{{"text": "build:\n"}},
+		}},
+		{10, "shutdown", nil},
+		{0, "exit", nil},
+	}
+	for _, r := range requests {
+		msg := map[string]interface{}{"jsonrpc": "2.0", "method": r.method, "params": r.params}
+		if r.id != 0 {
+			msg["id"] = r.id
+		}
+		b, err := json.Marshal(msg)
+		NoError(t, err)
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(b), b)
+	}
+	var out bytes.Buffer
+	NoError(t, NewServer(&in, &out).Serve(context.Background()))
+
+	responses := make(map[int]json.RawMessage)
+	errorCodes := make(map[int]int)
+	var diagnostics []publishDiagnosticsParams
+	r := bufio.NewReader(&out)
+	for r.Buffered() > 0 || out.Len() > 0 {
+		headers, err := textproto.NewReader(r).ReadMIMEHeader()
+		if !NoError(t, err) {
+			break
+		}
+		length, err := strconv.Atoi(headers.Get("Content-Length"))
+		NoError(t, err)
+		body := make([]byte, length)
+		_, err = io.ReadFull(r, body)
+		NoError(t, err)
+		var msg struct {
+			ID     int                      `json:"id"`
+			Method string                   `json:"method"`
+			Params publishDiagnosticsParams `json:"params"`
+			Result json.RawMessage          `json:"result"`
+			Error  *responseError           `json:"error"`
+		}
+		NoError(t, json.Unmarshal(body, &msg))
+		switch {
+		case msg.Method != "":
+			diagnostics = append(diagnostics, msg.Params)
+		case msg.Error != nil:
+			errorCodes[msg.ID] = msg.Error.Code
+		default:
+			responses[msg.ID] = msg.Result
+		}
+	}
+
+	labels := func(id int) []string {
+		var list completionList
+		NoError(t, json.Unmarshal(responses[id], &list))
+		var ret []string
+		for _, item := range list.Items {
+			ret = append(ret, item.Label)
+		}
+		return ret
+	}
+	Contains(t, labels(2), "SAVE ARTIFACT")
+	Equal(t, []string{"GLOBAL", "VERSION"}, labels(3))
+	Equal(t, []string{"--push", "--privileged", "--entrypoint", "--with-docker", "--ssh", "--secret", "--mount"}, labels(4))
+	Equal(t, []string{"build", "other"}, labels(5))
+
+	var h hover
+	NoError(t, json.Unmarshal(responses[6], &h))
+	Contains(t, h.Contents.Value, "RUN [--push]")
+
+	var locs []location
+	NoError(t, json.Unmarshal(responses[7], &locs))
+	Equal(t, []location{{URI: subURI, Range: lspRange{position{2, 0}, position{2, 3}}}}, locs)
+	NoError(t, json.Unmarshal(responses[8], &locs))
+	Equal(t, []location{{URI: uri, Range: lspRange{position{3, 0}, position{3, 5}}}}, locs)
+
+	Equal(t, codeMethodNotFound, errorCodes[9])
+
+	if Equal(t, 2, len(diagnostics)) {
+		Equal(t, 0, len(diagnostics[0].Diagnostics))
+		Equal(t, 1, len(diagnostics[1].Diagnostics))
+	}
+}
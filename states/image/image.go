@@ -61,6 +61,10 @@ func (img *Image) Clone() *Image {
 		}
 		copy(clone.Config.Healthcheck.Test, img.Config.Healthcheck.Test)
 	}
+	if img.Config.Shell != nil {
+		clone.Config.Shell = make([]string, len(img.Config.Shell))
+		copy(clone.Config.Shell, img.Config.Shell)
+	}
 	copy(clone.Config.Env, img.Config.Env)
 	copy(clone.Config.Entrypoint, img.Config.Entrypoint)
 	copy(clone.Config.Cmd, img.Config.Cmd)
@@ -87,4 +91,5 @@ type Config struct {
 	specs.ImageConfig
 
 	Healthcheck *dockerfile2llb.HealthConfig `json:",omitempty"`
+	Shell       []string                     `json:",omitempty"`
 }
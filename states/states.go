@@ -50,6 +50,8 @@ type SingleTarget struct {
 	// ie if there are any non-SAVE commands after the first SAVE command,
 	// or if the target is invoked via BUILD command (not COPY nor FROM).
 	HasDangling bool
+	// LocallyCommandStrs are the commands of the target executed on the host, via LOCALLY.
+	LocallyCommandStrs []string
 }
 
 // LastSaveImage returns the last save image available (if any).
@@ -179,6 +179,32 @@ func (c *Collection) WithResetEnvVars() *Collection {
 	return ret
 }
 
+// WithEnvVarsFrom returns a copy of the current collection, with the active env vars of the other
+// collection added in. This operation does not modify either collection.
+func (c *Collection) WithEnvVarsFrom(other *Collection) *Collection {
+	ret := NewCollection()
+	for k, v := range c.variables {
+		ret.variables[k] = v
+	}
+	for k := range c.activeVariables {
+		ret.activeVariables[k] = true
+	}
+	for k := range c.overridingVariables {
+		ret.overridingVariables[k] = true
+	}
+	for k := range c.globalVariables {
+		ret.globalVariables[k] = true
+	}
+	for k := range other.activeVariables {
+		v := other.variables[k]
+		if v.IsEnvVar() {
+			ret.variables[k] = v
+			ret.activeVariables[k] = true
+		}
+	}
+	return ret
+}
+
 // WithOnlyGlobals returns a copy of the current collection, keeping only the global variables.
 func (c *Collection) WithOnlyGlobals() *Collection {
 	ret := NewCollection()
@@ -213,6 +239,80 @@ func getProjectName(s string) string {
 	return s
 }
 
+// targetArgs are the builtin args determined by the target and by its git metadata.
+var targetArgs = []struct {
+	name string
+	// git is set for the args which are only available along with git metadata.
+	git   bool
+	value func(target domain.Target, gitMeta *gitutil.GitMetadata) string
+}{
+	{"EARTHLY_TARGET", false, func(target domain.Target, _ *gitutil.GitMetadata) string {
+		return target.StringCanonical()
+	}},
+	{"EARTHLY_TARGET_PROJECT", false, func(target domain.Target, _ *gitutil.GitMetadata) string {
+		return target.ProjectCanonical()
+	}},
+	{"EARTHLY_TARGET_NAME", false, func(target domain.Target, _ *gitutil.GitMetadata) string {
+		return target.Target
+	}},
+	{"EARTHLY_TARGET_TAG", false, func(target domain.Target, _ *gitutil.GitMetadata) string {
+		return target.Tag
+	}},
+	{"EARTHLY_TARGET_TAG_DOCKER", false, func(target domain.Target, _ *gitutil.GitMetadata) string {
+		return llbutil.DockerTagSafe(target.Tag)
+	}},
+	{"EARTHLY_GIT_HASH", true, func(_ domain.Target, gitMeta *gitutil.GitMetadata) string {
+		return gitMeta.Hash
+	}},
+	{"EARTHLY_GIT_BRANCH", true, func(_ domain.Target, gitMeta *gitutil.GitMetadata) string {
+		if len(gitMeta.Branch) > 0 {
+			return gitMeta.Branch[0]
+		}
+		return ""
+	}},
+	{"EARTHLY_GIT_TAG", true, func(_ domain.Target, gitMeta *gitutil.GitMetadata) string {
+		if len(gitMeta.Tags) > 0 {
+			return gitMeta.Tags[0]
+		}
+		return ""
+	}},
+	{"EARTHLY_GIT_ORIGIN_URL", true, func(_ domain.Target, gitMeta *gitutil.GitMetadata) string {
+		return gitMeta.RemoteURL
+	}},
+	{"EARTHLY_GIT_ORIGIN_URL_SCRUBBED", true, func(_ domain.Target, gitMeta *gitutil.GitMetadata) string {
+		return stringutil.ScrubCredentials(gitMeta.RemoteURL)
+	}},
+	{"EARTHLY_GIT_PROJECT_NAME", true, func(_ domain.Target, gitMeta *gitutil.GitMetadata) string {
+		return getProjectName(gitMeta.RemoteURL)
+	}},
+}
+
+// platformArgs are the builtin args determined by the target platform.
+var platformArgs = []struct {
+	name  string
+	value func(platform specs.Platform) string
+}{
+	{"TARGETPLATFORM", platforms.Format},
+	{"TARGETOS", func(platform specs.Platform) string { return platform.OS }},
+	{"TARGETARCH", func(platform specs.Platform) string { return platform.Architecture }},
+	{"TARGETVARIANT", func(platform specs.Platform) string { return platform.Variant }},
+}
+
+// BuiltinArgs are the names of the builtin args. Their values are set by earthly and take
+// precedence over the default values of ARG declarations.
+var BuiltinArgs = builtinArgNames()
+
+func builtinArgNames() []string {
+	var ret []string
+	for _, ta := range targetArgs {
+		ret = append(ret, ta.name)
+	}
+	for _, pa := range platformArgs {
+		ret = append(ret, pa.name)
+	}
+	return ret
+}
+
 // WithBuiltinBuildArgs returns a new collection containing the current variables together with
 // builtin args. This operation does not modify the current collection.
 func (c *Collection) WithBuiltinBuildArgs(target domain.Target, platform specs.Platform, gitMeta *gitutil.GitMetadata) *Collection {
@@ -229,38 +329,21 @@ func (c *Collection) WithBuiltinBuildArgs(target domain.Target, platform specs.P
 		ret.activeVariables[k] = true
 	}
 	// Add the builtin build args.
-	ret.variables["EARTHLY_TARGET"] = NewConstant(target.StringCanonical())
-	ret.variables["EARTHLY_TARGET_PROJECT"] = NewConstant(target.ProjectCanonical())
-	ret.variables["EARTHLY_TARGET_NAME"] = NewConstant(target.Target)
-	ret.variables["EARTHLY_TARGET_TAG"] = NewConstant(target.Tag)
-	ret.variables["EARTHLY_TARGET_TAG_DOCKER"] = NewConstant(llbutil.DockerTagSafe(target.Tag))
-	ret.SetPlatformArgs(platform)
-
-	if gitMeta != nil {
-		ret.variables["EARTHLY_GIT_HASH"] = NewConstant(gitMeta.Hash)
-		branch := ""
-		if len(gitMeta.Branch) > 0 {
-			branch = gitMeta.Branch[0]
-		}
-		ret.variables["EARTHLY_GIT_BRANCH"] = NewConstant(branch)
-		tag := ""
-		if len(gitMeta.Tags) > 0 {
-			tag = gitMeta.Tags[0]
+	for _, ta := range targetArgs {
+		if ta.git && gitMeta == nil {
+			continue
 		}
-		ret.variables["EARTHLY_GIT_TAG"] = NewConstant(tag)
-		ret.variables["EARTHLY_GIT_ORIGIN_URL"] = NewConstant(gitMeta.RemoteURL)
-		ret.variables["EARTHLY_GIT_ORIGIN_URL_SCRUBBED"] = NewConstant(stringutil.ScrubCredentials(gitMeta.RemoteURL))
-		ret.variables["EARTHLY_GIT_PROJECT_NAME"] = NewConstant(getProjectName(gitMeta.RemoteURL))
+		ret.variables[ta.name] = NewConstant(ta.value(target, gitMeta))
 	}
+	ret.SetPlatformArgs(platform)
 	return ret
 }
 
 // SetPlatformArgs set the platform-specific built-in args to a specific platform.
 func (c *Collection) SetPlatformArgs(platform specs.Platform) {
-	c.variables["TARGETPLATFORM"] = NewConstant(platforms.Format(platform))
-	c.variables["TARGETOS"] = NewConstant(platform.OS)
-	c.variables["TARGETARCH"] = NewConstant(platform.Architecture)
-	c.variables["TARGETVARIANT"] = NewConstant(platform.Variant)
+	for _, pa := range platformArgs {
+		c.variables[pa.name] = NewConstant(pa.value(platform))
+	}
 }
 
 // WithParseBuildArgs takes in a slice of build args to be parsed and returns another collection